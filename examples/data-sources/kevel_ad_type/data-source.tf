@@ -0,0 +1,3 @@
+data "kevel_ad_type" "example" {
+  name = "Medium Rectangle"
+}
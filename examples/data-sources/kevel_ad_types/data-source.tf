@@ -0,0 +1,4 @@
+data "kevel_ad_types" "example" {
+  width  = 300
+  height = 250
+}
@@ -0,0 +1,3 @@
+data "kevel_channel" "example" {
+  title = "My Channel"
+}
@@ -0,0 +1,4 @@
+data "kevel_channel_site_map" "example" {
+  channel_id = data.kevel_channel.example.id
+  site_id    = data.kevel_site.example.id
+}
@@ -0,0 +1,3 @@
+data "kevel_channels" "example" {
+  title_regex = "^Display"
+}
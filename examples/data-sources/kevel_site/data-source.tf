@@ -0,0 +1,3 @@
+data "kevel_site" "example" {
+  title = "My Site"
+}
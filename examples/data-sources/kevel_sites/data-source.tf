@@ -0,0 +1,3 @@
+data "kevel_sites" "example" {
+  url_regex = "^https://(www\\.)?example\\.org/"
+}
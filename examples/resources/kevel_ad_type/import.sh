@@ -0,0 +1,6 @@
+# Ad types can be imported by ID
+terraform import kevel_ad_type.example 123
+
+# or by name or size, which must match exactly one ad type
+terraform import kevel_ad_type.example "name:Medium Rectangle"
+terraform import kevel_ad_type.example "size:300x250"
@@ -0,0 +1,5 @@
+# Channels can be imported by ID
+terraform import kevel_channel.example 123
+
+# or by title, which must match exactly one channel that is not deleted
+terraform import kevel_channel.example "title:My Channel"
@@ -0,0 +1,5 @@
+# Sites can be imported by ID
+terraform import kevel_site.example 123
+
+# or by title, which must match exactly one site that is not deleted
+terraform import kevel_site.example "title:My Site"
@@ -0,0 +1,4 @@
+resource "kevel_zone" "example" {
+  name    = "My Zone"
+  site_id = kevel_site.example.id
+}
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	adzerk "github.com/cysp/adzerk-management-sdk-go"
+)
+
+var (
+	_ datasource.DataSource                     = &adTypeDataSource{}
+	_ datasource.DataSourceWithConfigure        = &adTypeDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &adTypeDataSource{}
+)
+
+func NewAdTypeDataSource() datasource.DataSource {
+	return &adTypeDataSource{}
+}
+
+type adTypeDataSource struct {
+	client  *adzerk.ClientWithResponses
+	adTypes *adTypeIndex
+}
+
+type adTypeDataSourceModel struct {
+	Id     types.Int64  `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Width  types.Int64  `tfsdk:"width"`
+	Height types.Int64  `tfsdk:"height"`
+}
+
+func (d *adTypeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ad_type"
+}
+
+func (d *adTypeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Kevel AdType",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "Numeric identifier of the ad type",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the ad type. Must match exactly one ad type",
+				Optional:    true,
+				Computed:    true,
+			},
+			"width": schema.Int64Attribute{
+				Description: "Width of the ad type",
+				Computed:    true,
+			},
+			"height": schema.Int64Attribute{
+				Description: "Height of the ad type",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *adTypeDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *adTypeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*kevelProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Error", "Could not get client from provider data")
+		return
+	}
+
+	d.client = providerData.client
+	d.adTypes = providerData.adTypes
+}
+
+func (d *adTypeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config adTypeDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	adTypes, err := d.adTypes.all(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Kevel AdType",
+			"Could not list ad types, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if !config.Id.IsNull() {
+		adType, count := findUnique(adTypes, func(adType adzerk.AdType) bool {
+			return int64(adType.Id) == config.Id.ValueInt64()
+		})
+
+		if count == 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Error Reading Kevel AdType",
+				"Could not read ad type ID "+config.Id.String()+", ad type not found",
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(setStateWithAdType(&resp.State, ctx, adType)...)
+		return
+	}
+
+	adType, count := findUnique(adTypes, func(adType adzerk.AdType) bool {
+		return adType.Name != nil && *adType.Name == config.Name.ValueString()
+	})
+
+	if count != 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Error Reading Kevel AdType",
+			"Expected exactly one ad type with name "+config.Name.String()+", found "+strconv.Itoa(count),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setStateWithAdType(&resp.State, ctx, adType)...)
+}
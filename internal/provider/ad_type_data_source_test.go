@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/cysp/adzerk-management-sdk-go/testserver"
+)
+
+func TestAdTypeDataSource(t *testing.T) {
+	s := testserver.NewHttpTestServer()
+	defer s.Close()
+
+	name := "name"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testAdTypeResourceConfig(640, 480, &name),
+					testDataSourceConfig("ad_type", `id = kevel_ad_type.test.id`),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.kevel_ad_type.test", "id", "kevel_ad_type.test", "id"),
+					resource.TestCheckResourceAttr("data.kevel_ad_type.test", "name", "name"),
+					resource.TestCheckResourceAttr("data.kevel_ad_type.test", "width", "640"),
+					resource.TestCheckResourceAttr("data.kevel_ad_type.test", "height", "480"),
+				),
+			},
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testAdTypeResourceConfig(640, 480, &name),
+					testDataSourceConfig("ad_type", `name = kevel_ad_type.test.name`),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.kevel_ad_type.test", "id", "kevel_ad_type.test", "id"),
+					resource.TestCheckResourceAttr("data.kevel_ad_type.test", "width", "640"),
+					resource.TestCheckResourceAttr("data.kevel_ad_type.test", "height", "480"),
+				),
+			},
+		},
+	})
+}
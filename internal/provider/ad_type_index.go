@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	adzerk "github.com/cysp/adzerk-management-sdk-go"
+)
+
+// adTypeIndex caches the network's ad types keyed by ID. Kevel can only list
+// ad types, so without the index every kevel_ad_type resource would page
+// through the full list to find itself.
+type adTypeIndex struct {
+	client *adzerk.ClientWithResponses
+
+	mu      sync.Mutex
+	adTypes map[int32]adzerk.AdType
+}
+
+func newAdTypeIndex(client *adzerk.ClientWithResponses) *adTypeIndex {
+	return &adTypeIndex{client: client}
+}
+
+// load populates the index on first use. The caller must hold i.mu.
+func (i *adTypeIndex) load(ctx context.Context) error {
+	if i.adTypes != nil {
+		return nil
+	}
+
+	adTypes, err := listAllAdTypes(ctx, i.client)
+	if err != nil {
+		return err
+	}
+
+	i.adTypes = make(map[int32]adzerk.AdType, len(adTypes))
+	for _, adType := range adTypes {
+		i.adTypes[adType.Id] = adType
+	}
+
+	return nil
+}
+
+// get returns the ad type with the given ID, or nil if there is none.
+func (i *adTypeIndex) get(ctx context.Context, id int32) (*adzerk.AdType, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if err := i.load(ctx); err != nil {
+		return nil, err
+	}
+
+	adType, found := i.adTypes[id]
+	if !found {
+		return nil, nil
+	}
+
+	return &adType, nil
+}
+
+// all returns every ad type in the index, ordered by ID.
+func (i *adTypeIndex) all(ctx context.Context) ([]adzerk.AdType, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if err := i.load(ctx); err != nil {
+		return nil, err
+	}
+
+	adTypes := make([]adzerk.AdType, 0, len(i.adTypes))
+	for _, adType := range i.adTypes {
+		adTypes = append(adTypes, adType)
+	}
+
+	sort.Slice(adTypes, func(a, b int) bool {
+		return adTypes[a].Id < adTypes[b].Id
+	})
+
+	return adTypes, nil
+}
+
+// put records an ad type created by the provider.
+func (i *adTypeIndex) put(adType adzerk.AdType) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.adTypes != nil {
+		i.adTypes[adType.Id] = adType
+	}
+}
+
+// remove forgets an ad type deleted by the provider.
+func (i *adTypeIndex) remove(id int32) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.adTypes != nil {
+		delete(i.adTypes, id)
+	}
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	adzerk "github.com/cysp/adzerk-management-sdk-go"
+)
+
+func TestAdTypeIndex(t *testing.T) {
+	const totalItems = 1234
+
+	var requests atomic.Int32
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+
+		// Serve items in descending ID order to ensure nothing relies on sorting.
+		items := []adzerk.AdType{}
+		for i := (page - 1) * pageSize; i < page*pageSize && i < totalItems; i++ {
+			id := int32(totalItems - i)
+			items = append(items, adzerk.AdType{Id: id, Width: id, Height: id})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(adzerk.AdTypeList{
+			Items:      items,
+			Page:       int32(page),
+			PageSize:   int32(pageSize),
+			TotalItems: totalItems,
+			TotalPages: int32((totalItems + pageSize - 1) / pageSize),
+		})
+	}))
+	defer s.Close()
+
+	index := newAdTypeIndex(testClient(t, s.URL))
+
+	for _, id := range []int32{1, 500, 501, totalItems} {
+		adType, err := index.get(context.Background(), id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if adType == nil || adType.Id != id {
+			t.Fatalf("expected ad type %d, got %v", id, adType)
+		}
+	}
+
+	adType, err := index.get(context.Background(), totalItems+1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if adType != nil {
+		t.Fatalf("expected no ad type, got %v", adType)
+	}
+
+	expectedRequests := int32((totalItems + listPageSize - 1) / listPageSize)
+	if requests.Load() != expectedRequests {
+		t.Fatalf("expected %d list requests, got %d", expectedRequests, requests.Load())
+	}
+
+	index.put(adzerk.AdType{Id: totalItems + 1})
+	index.remove(1)
+
+	adTypes, err := index.all(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(adTypes) != totalItems || adTypes[0].Id != 2 || adTypes[len(adTypes)-1].Id != totalItems+1 {
+		t.Fatalf("unexpected ad types after put and remove: %d items", len(adTypes))
+	}
+
+	if requests.Load() != expectedRequests {
+		t.Fatalf("expected no further list requests, got %d", requests.Load()-expectedRequests)
+	}
+}
@@ -2,22 +2,25 @@ package provider
 
 import (
 	"context"
-	"sort"
 	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 
 	adzerk "github.com/cysp/adzerk-management-sdk-go"
 )
 
 var (
-	_ resource.Resource                = &adTypeResource{}
-	_ resource.ResourceWithConfigure   = &adTypeResource{}
-	_ resource.ResourceWithImportState = &adTypeResource{}
+	_ resource.Resource                 = &adTypeResource{}
+	_ resource.ResourceWithConfigure    = &adTypeResource{}
+	_ resource.ResourceWithImportState  = &adTypeResource{}
+	_ resource.ResourceWithUpgradeState = &adTypeResource{}
 )
 
 func NewAdTypeResource() resource.Resource {
@@ -25,16 +28,18 @@ func NewAdTypeResource() resource.Resource {
 }
 
 type adTypeResource struct {
-	client *adzerk.ClientWithResponses
+	client  *adzerk.ClientWithResponses
+	adTypes *adTypeIndex
 }
 
 func (r *adTypeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_ad_type"
 }
 
-func (r *adTypeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *adTypeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Kevel AdType",
+		Version:     1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				Description: "Numeric identifier of the ad type",
@@ -47,6 +52,9 @@ func (r *adTypeResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Description: "Name of the ad type",
 				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"width": schema.Int64Attribute{
 				Description: "Width of the ad type",
@@ -63,6 +71,13 @@ func (r *adTypeResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -71,13 +86,14 @@ func (r *adTypeResource) Configure(_ context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	client, ok := req.ProviderData.(*adzerk.ClientWithResponses)
+	providerData, ok := req.ProviderData.(*kevelProviderData)
 	if !ok {
 		resp.Diagnostics.AddError("Error", "Could not get client from provider data")
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
+	r.adTypes = providerData.adTypes
 }
 
 func (r *adTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -88,6 +104,15 @@ func (r *adTypeResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	response, err := r.client.CreateAdTypeWithResponse(ctx, plan.createRequestBody())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -97,7 +122,17 @@ func (r *adTypeResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(checkResponse(response.StatusCode(), response.Body, "Error creating ad type", "Could not create ad type", adTypeResponseFields)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if response.JSON200 != nil {
+		r.adTypes.put(*response.JSON200)
+	}
+
 	resp.Diagnostics.Append(setStateWithAdType(&resp.State, ctx, response.JSON200)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeouts"), plan.Timeouts)...)
 }
 
 func (r *adTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -108,41 +143,35 @@ func (r *adTypeResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	response, err := r.client.ListAdTypesWithResponse(ctx, &adzerk.ListAdTypesParams{})
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Kevel AdType",
-			"Could not read ad type ID "+state.Id.String()+", unexpected error: "+err.Error(),
-		)
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	adTypeList := response.JSON200
-	if adTypeList == nil {
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	adType, err := r.adTypes.get(ctx, int32(state.Id.ValueInt64()))
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Kevel AdType",
-			"Could not read ad type ID "+state.Id.String()+", unexpected error: ad type list is nil",
+			"Could not read ad type ID "+state.Id.String()+", unexpected error: "+err.Error(),
 		)
 		return
 	}
 
-	adTypeIndex, adTypeFound := sort.Find(len(adTypeList.Items), func(i int) int {
-		return int(int32(state.Id.ValueInt64()) - (adTypeList.Items)[i].Id)
-	})
-
-	if !adTypeFound {
-		resp.Diagnostics.AddError(
-			"Error Reading Kevel AdType",
-			"Could not read ad type ID "+state.Id.String()+", ad type not found",
-		)
+	if adType == nil {
+		resp.State.RemoveResource(ctx)
 		return
 	}
 
-	adType := (adTypeList.Items)[adTypeIndex]
-
-	resp.Diagnostics.Append(setStateWithAdType(&resp.State, ctx, &adType)...)
+	resp.Diagnostics.Append(setStateWithAdType(&resp.State, ctx, adType)...)
 }
 
+// Update only ever changes the timeouts, as Kevel has no way to modify an
+// existing ad type and every other configurable attribute requires
+// replacement, so it records them in state without calling Kevel.
 func (r *adTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan adTypeResourceModel
 
@@ -151,16 +180,7 @@ func (r *adTypeResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	response, err := r.client.CreateAdTypeWithResponse(ctx, plan.createRequestBody())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating Kevel AdType",
-			"Could not update ad type ID "+plan.Id.String()+", unexpected error: "+err.Error(),
-		)
-		return
-	}
-
-	resp.Diagnostics.Append(setStateWithAdType(&resp.State, ctx, response.JSON200)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeouts"), plan.Timeouts)...)
 }
 
 func (r *adTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -171,6 +191,15 @@ func (r *adTypeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	response, err := r.client.DeleteAdTypeWithResponse(ctx, int32(state.Id.ValueInt64()))
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -180,15 +209,56 @@ func (r *adTypeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	if response.StatusCode() != 200 {
-		resp.Diagnostics.AddError(
-			"Error Deleting Kevel AdType",
-			"Could not delete ad type ID "+state.Id.String()+", unexpected status code: "+strconv.Itoa(response.StatusCode()),
-		)
+	resp.Diagnostics.Append(checkResponse(response.StatusCode(), response.Body, "Error Deleting Kevel AdType", "Could not delete ad type ID "+state.Id.String(), adTypeResponseFields)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	r.adTypes.remove(int32(state.Id.ValueInt64()))
 }
 
+// ImportState accepts either a numeric ID, "name:<name>" or
+// "size:<width>x<height>", which must match exactly one ad type.
 func (r *adTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	ImportStatePassthroughInt64ID(ctx, path.Root("id"), req, resp)
+	var match func(adType adzerk.AdType) bool
+	var description string
+
+	if name, found := strings.CutPrefix(req.ID, "name:"); found {
+		match = func(adType adzerk.AdType) bool {
+			return adType.Name != nil && *adType.Name == name
+		}
+		description = "name " + strconv.Quote(name)
+	} else if size, found := strings.CutPrefix(req.ID, "size:"); found {
+		matches := importAdTypeSizeRegExp.FindStringSubmatch(size)
+		if matches == nil {
+			resp.Diagnostics.AddError(
+				"Error importing Kevel AdType",
+				"Could not import ad type, error parsing size "+strconv.Quote(size)+", expected <width>x<height>",
+			)
+			return
+		}
+
+		width, _ := strconv.ParseInt(matches[1], 10, 64)
+		height, _ := strconv.ParseInt(matches[2], 10, 64)
+		match = func(adType adzerk.AdType) bool {
+			return int64(adType.Width) == width && int64(adType.Height) == height
+		}
+		description = "size " + size
+	} else {
+		ImportStatePassthroughInt64ID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	adTypes, err := r.adTypes.all(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing Kevel AdType",
+			"Could not list ad types, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	importStateMatching(ctx, adTypes, match,
+		func(adType adzerk.AdType) int32 { return adType.Id },
+		"ad type", description, "Error importing Kevel AdType", req, resp)
 }
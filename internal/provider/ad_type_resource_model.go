@@ -1,16 +1,25 @@
 package provider
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	adzerk "github.com/cysp/adzerk-management-sdk-go"
 )
 
+var adTypeResponseFields = []responseField{
+	newResponseField("Name", path.Root("name")),
+	newResponseField("Width", path.Root("width")),
+	newResponseField("Height", path.Root("height")),
+}
+
 type adTypeResourceModel struct {
-	Id     types.Int64  `tfsdk:"id"`
-	Name   types.String `tfsdk:"name"`
-	Width  types.Int64  `tfsdk:"width"`
-	Height types.Int64  `tfsdk:"height"`
+	Id       types.Int64    `tfsdk:"id"`
+	Name     types.String   `tfsdk:"name"`
+	Width    types.Int64    `tfsdk:"width"`
+	Height   types.Int64    `tfsdk:"height"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (m *adTypeResourceModel) createRequestBody() adzerk.CreateAdTypeJSONRequestBody {
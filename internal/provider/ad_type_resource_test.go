@@ -1,12 +1,19 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
+	adzerk "github.com/cysp/adzerk-management-sdk-go"
 	"github.com/cysp/adzerk-management-sdk-go/testserver"
 )
 
@@ -14,6 +21,8 @@ func TestAdTypeResource(t *testing.T) {
 	s := testserver.NewHttpTestServer()
 	defer s.Close()
 
+	client := testClient(t, s.URL)
+
 	name := "name"
 
 	resource.UnitTest(t, resource.TestCase{
@@ -44,9 +53,25 @@ func TestAdTypeResource(t *testing.T) {
 				),
 				ConfigPlanChecks: resource.ConfigPlanChecks{
 					PreApply: []plancheck.PlanCheck{
-						plancheck.ExpectResourceAction("kevel_ad_type.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction("kevel_ad_type.test", plancheck.ResourceActionDestroyBeforeCreate),
 					},
 				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kevel_ad_type.test", "name", "name"),
+					testCheckAdTypeCount(client, 1),
+				),
+			},
+			{
+				ResourceName:      "kevel_ad_type.test",
+				ImportState:       true,
+				ImportStateId:     "name:name",
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "kevel_ad_type.test",
+				ImportState:       true,
+				ImportStateId:     "size:640x480",
+				ImportStateVerify: true,
 			},
 			{
 				Config: testCombinedConfig(
@@ -58,12 +83,38 @@ func TestAdTypeResource(t *testing.T) {
 						plancheck.ExpectResourceAction("kevel_ad_type.test", plancheck.ResourceActionDestroyBeforeCreate),
 					},
 				},
+				Check: testCheckAdTypeCount(client, 1),
 			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
 
+// testCheckAdTypeCount verifies the number of ad types known to the server,
+// ensuring that replacing an ad type does not leave the old one behind.
+func testCheckAdTypeCount(client *adzerk.ClientWithResponses, expected int) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		adTypes, err := listAllAdTypes(context.Background(), client)
+		if err != nil {
+			return err
+		}
+
+		// The test server pads its list response with zero-valued items.
+		count := 0
+		for _, adType := range adTypes {
+			if adType.Id != 0 {
+				count++
+			}
+		}
+
+		if count != expected {
+			return fmt.Errorf("expected %d ad types, found %d", expected, count)
+		}
+
+		return nil
+	}
+}
+
 func testAdTypeResourceConfig(width int32, height int32, name *string) string {
 	widthField := fmt.Sprintf(`width = %d`, width)
 	heightField := fmt.Sprintf(`height = %d`, height)
@@ -73,3 +124,64 @@ func testAdTypeResourceConfig(width int32, height int32, name *string) string {
 	}
 	return testResourceConfig("ad_type", widthField, heightField, nameField)
 }
+
+func TestAdTypeResourceDisappears(t *testing.T) {
+	s := testserver.NewHttpTestServer()
+	defer s.Close()
+
+	client := testClient(t, s.URL)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testAdTypeResourceConfig(640, 480, nil),
+				),
+				Check: testCheckResourceAttrInt32Func("kevel_ad_type.test", "id", func(id int32) error {
+					_, err := client.DeleteAdTypeWithResponse(context.Background(), id)
+					return err
+				}),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("kevel_ad_type.test", plancheck.ResourceActionCreate),
+					},
+				},
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAdTypeResourceUpdateTimeouts(t *testing.T) {
+	ctx := context.Background()
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer s.Close()
+
+	r, ok := NewAdTypeResource().(fwresource.ResourceWithConfigure)
+	if !ok {
+		t.Fatal("expected resource to implement ResourceWithConfigure")
+	}
+
+	r.Configure(ctx, fwresource.ConfigureRequest{ProviderData: newKevelProviderData(testClient(t, s.URL))}, &fwresource.ConfigureResponse{})
+
+	state := testUpgradeResourceState(t, r, "kevel_ad_type", 1, `{"id":1,"name":"one","width":640,"height":480,"timeouts":null}`)
+	planned := testUpgradeResourceState(t, r, "kevel_ad_type", 1, `{"id":1,"name":"one","width":640,"height":480,"timeouts":{"create":"1m","read":null,"delete":null}}`)
+
+	updateResponse := fwresource.UpdateResponse{State: state}
+	r.Update(ctx, fwresource.UpdateRequest{State: state, Plan: tfsdk.Plan{Schema: planned.Schema, Raw: planned.Raw}}, &updateResponse)
+
+	if updateResponse.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", updateResponse.Diagnostics)
+	}
+
+	if !updateResponse.State.Raw.Equal(planned.Raw) {
+		t.Errorf("expected state to match plan, got %v", updateResponse.State.Raw)
+	}
+}
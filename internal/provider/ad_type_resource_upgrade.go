@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// adTypeResourceModelV0 is the state of an ad type before the timeouts block
+// was added.
+type adTypeResourceModelV0 struct {
+	Id     types.Int64  `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Width  types.Int64  `tfsdk:"width"`
+	Height types.Int64  `tfsdk:"height"`
+}
+
+func adTypeResourceSchemaV0() *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"width": schema.Int64Attribute{
+				Required: true,
+			},
+			"height": schema.Int64Attribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *adTypeResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   adTypeResourceSchemaV0(),
+			StateUpgrader: upgradeAdTypeResourceStateV0,
+		},
+	}
+}
+
+func upgradeAdTypeResourceStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior adTypeResourceModelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeoutsValue, diags := nullTimeouts(ctx, resp.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, adTypeResourceModel{
+		Id:       prior.Id,
+		Name:     prior.Name,
+		Width:    prior.Width,
+		Height:   prior.Height,
+		Timeouts: timeoutsValue,
+	})...)
+}
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	adzerk "github.com/cysp/adzerk-management-sdk-go"
+)
+
+var (
+	_ datasource.DataSource              = &adTypesDataSource{}
+	_ datasource.DataSourceWithConfigure = &adTypesDataSource{}
+)
+
+func NewAdTypesDataSource() datasource.DataSource {
+	return &adTypesDataSource{}
+}
+
+type adTypesDataSource struct {
+	client  *adzerk.ClientWithResponses
+	adTypes *adTypeIndex
+}
+
+type adTypesDataSourceModel struct {
+	NameRegex types.String                 `tfsdk:"name_regex"`
+	Width     types.Int64                  `tfsdk:"width"`
+	Height    types.Int64                  `tfsdk:"height"`
+	AdTypes   []adTypesDataSourceItemModel `tfsdk:"ad_types"`
+}
+
+type adTypesDataSourceItemModel struct {
+	Id     types.Int64  `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Width  types.Int64  `tfsdk:"width"`
+	Height types.Int64  `tfsdk:"height"`
+}
+
+func (d *adTypesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ad_types"
+}
+
+func (d *adTypesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Kevel AdTypes",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Description: "Regular expression that ad type names must match",
+				Optional:    true,
+			},
+			"width": schema.Int64Attribute{
+				Description: "Width that ad types must match",
+				Optional:    true,
+			},
+			"height": schema.Int64Attribute{
+				Description: "Height that ad types must match",
+				Optional:    true,
+			},
+			"ad_types": schema.ListNestedAttribute{
+				Description: "List of matching ad types",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "Numeric identifier of the ad type",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the ad type",
+							Computed:    true,
+						},
+						"width": schema.Int64Attribute{
+							Description: "Width of the ad type",
+							Computed:    true,
+						},
+						"height": schema.Int64Attribute{
+							Description: "Height of the ad type",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *adTypesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*kevelProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Error", "Could not get client from provider data")
+		return
+	}
+
+	d.client = providerData.client
+	d.adTypes = providerData.adTypes
+}
+
+func (d *adTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data adTypesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameRegexp := compileRegexpAttribute(path.Root("name_regex"), data.NameRegex, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	adTypes, err := d.adTypes.all(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Kevel AdTypes",
+			"Could not list ad types, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.AdTypes = []adTypesDataSourceItemModel{}
+	for _, adType := range adTypes {
+		if !data.Width.IsNull() && int64(adType.Width) != data.Width.ValueInt64() {
+			continue
+		}
+		if !data.Height.IsNull() && int64(adType.Height) != data.Height.ValueInt64() {
+			continue
+		}
+		if nameRegexp != nil && (adType.Name == nil || !nameRegexp.MatchString(*adType.Name)) {
+			continue
+		}
+
+		data.AdTypes = append(data.AdTypes, adTypesDataSourceItemModel{
+			Id:     types.Int64Value(int64(adType.Id)),
+			Name:   types.StringPointerValue(adType.Name),
+			Width:  types.Int64Value(int64(adType.Width)),
+			Height: types.Int64Value(int64(adType.Height)),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
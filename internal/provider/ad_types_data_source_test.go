@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/cysp/adzerk-management-sdk-go/testserver"
+)
+
+func TestAdTypesDataSource(t *testing.T) {
+	s := testserver.NewHttpTestServer()
+	defer s.Close()
+
+	name := "name"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testAdTypeResourceConfig(640, 480, &name),
+					testDataSourceConfig("ad_types",
+						`width = kevel_ad_type.test.width`,
+						`height = kevel_ad_type.test.height`,
+					),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kevel_ad_types.test", "ad_types.#", "1"),
+					resource.TestCheckResourceAttrPair("data.kevel_ad_types.test", "ad_types.0.id", "kevel_ad_type.test", "id"),
+					resource.TestCheckResourceAttr("data.kevel_ad_types.test", "ad_types.0.name", "name"),
+				),
+			},
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testAdTypeResourceConfig(640, 480, &name),
+					testDataSourceConfig("ad_types",
+						`name_regex = "^nomatch$"`,
+						`width = kevel_ad_type.test.width`,
+					),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kevel_ad_types.test", "ad_types.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	adzerk "github.com/cysp/adzerk-management-sdk-go"
+)
+
+var (
+	_ datasource.DataSource                     = &channelDataSource{}
+	_ datasource.DataSourceWithConfigure        = &channelDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &channelDataSource{}
+)
+
+func NewChannelDataSource() datasource.DataSource {
+	return &channelDataSource{}
+}
+
+type channelDataSource struct {
+	client *adzerk.ClientWithResponses
+}
+
+type channelDataSourceModel struct {
+	Id      types.Int64  `tfsdk:"id"`
+	Title   types.String `tfsdk:"title"`
+	AdTypes types.Set    `tfsdk:"ad_types"`
+}
+
+func (d *channelDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel"
+}
+
+func (d *channelDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Kevel Channel",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "Numeric identifier of the channel",
+				Optional:    true,
+				Computed:    true,
+			},
+			"title": schema.StringAttribute{
+				Description: "Title of the channel. Must match exactly one channel that is not deleted",
+				Optional:    true,
+				Computed:    true,
+			},
+			"ad_types": schema.SetAttribute{
+				Description: "Set of ad types",
+				ElementType: types.Int64Type,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *channelDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("title"),
+		),
+	}
+}
+
+func (d *channelDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*kevelProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Error", "Could not get client from provider data")
+		return
+	}
+
+	d.client = providerData.client
+}
+
+func (d *channelDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config channelDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Id.IsNull() {
+		response, err := d.client.GetChannelWithResponse(ctx, int32(config.Id.ValueInt64()))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Kevel Channel",
+				"Could not read channel ID "+config.Id.String()+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(checkResponse(response.StatusCode(), response.Body, "Error Reading Kevel Channel", "Could not read channel ID "+config.Id.String(), nil)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Kevel answers a request for an unknown ID with a null body, which
+		// decodes to an empty channel.
+		if response.JSON200 == nil || response.JSON200.Id == 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Error Reading Kevel Channel",
+				"No channel found with ID "+config.Id.String(),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(setStateWithChannel(&resp.State, ctx, response.JSON200)...)
+		return
+	}
+
+	channels, err := listAllChannels(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Kevel Channel",
+			"Could not list channels, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	channel, count := findUnique(channels, func(channel adzerk.Channel) bool {
+		return channel.Title == config.Title.ValueString() && (channel.IsDeleted == nil || !*channel.IsDeleted)
+	})
+
+	if count != 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("title"),
+			"Error Reading Kevel Channel",
+			"Expected exactly one channel with title "+config.Title.String()+", found "+strconv.Itoa(count),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setStateWithChannel(&resp.State, ctx, channel)...)
+}
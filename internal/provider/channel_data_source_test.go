@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	adzerk "github.com/cysp/adzerk-management-sdk-go"
+	"github.com/cysp/adzerk-management-sdk-go/testserver"
+)
+
+func TestChannelDataSource(t *testing.T) {
+	s := testserver.NewHttpTestServer()
+	defer s.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testChannelResourceConfig("one", []int32{123, 234}),
+					testDataSourceConfig("channel", `id = kevel_channel.test.id`),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.kevel_channel.test", "id", "kevel_channel.test", "id"),
+					resource.TestCheckResourceAttr("data.kevel_channel.test", "title", "one"),
+					resource.TestCheckResourceAttr("data.kevel_channel.test", "ad_types.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestChannelDataSourceLookup(t *testing.T) {
+	isDeleted := true
+
+	s := newTestServerWithLists(nil, []adzerk.Channel{
+		{Id: 1, Title: "Example", AdTypes: []int32{5, 4}},
+		{Id: 2, Title: "Duplicate", AdTypes: []int32{}},
+		{Id: 3, Title: "Duplicate", AdTypes: []int32{}},
+		{Id: 4, Title: "Archive", AdTypes: []int32{}, IsDeleted: &isDeleted},
+	})
+	defer s.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testDataSourceConfig("channel", `title = "Example"`),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kevel_channel.test", "id", "1"),
+					resource.TestCheckResourceAttr("data.kevel_channel.test", "ad_types.#", "2"),
+				),
+			},
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testDataSourceConfig("channel", `title = "Missing"`),
+				),
+				ExpectError: regexp.MustCompile(`Expected\s+exactly\s+one\s+channel\s+with\s+title\s+"Missing",\s+found\s+0`),
+			},
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testDataSourceConfig("channel", `title = "Duplicate"`),
+				),
+				ExpectError: regexp.MustCompile(`Expected\s+exactly\s+one\s+channel\s+with\s+title\s+"Duplicate",\s+found\s+2`),
+			},
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testDataSourceConfig("channel", `title = "Archive"`),
+				),
+				ExpectError: regexp.MustCompile(`Expected\s+exactly\s+one\s+channel\s+with\s+title\s+"Archive",\s+found\s+0`),
+			},
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testDataSourceConfig("channel", `id = 999`),
+				),
+				ExpectError: regexp.MustCompile(`No\s+channel\s+found\s+with\s+ID\s+999`),
+			},
+		},
+	})
+}
@@ -3,7 +3,9 @@ package provider
 import (
 	"context"
 	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -15,9 +17,11 @@ import (
 )
 
 var (
-	_ resource.Resource                = &channelResource{}
-	_ resource.ResourceWithConfigure   = &channelResource{}
-	_ resource.ResourceWithImportState = &channelResource{}
+	_ resource.Resource                 = &channelResource{}
+	_ resource.ResourceWithConfigure    = &channelResource{}
+	_ resource.ResourceWithImportState  = &channelResource{}
+	_ resource.ResourceWithUpgradeState = &channelResource{}
+	_ resource.ResourceWithMoveState    = &channelResource{}
 )
 
 func NewChannelResource() resource.Resource {
@@ -32,9 +36,10 @@ func (r *channelResource) Metadata(_ context.Context, req resource.MetadataReque
 	resp.TypeName = req.ProviderTypeName + "_channel"
 }
 
-func (r *channelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *channelResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Kevel Channel",
+		Version:     1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				Description: "Numeric identifier of the channel",
@@ -47,12 +52,20 @@ func (r *channelResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Description: "Title of the channel",
 				Required:    true,
 			},
-			"ad_types": schema.ListAttribute{
-				Description: "List of ad types",
+			"ad_types": schema.SetAttribute{
+				Description: "Set of ad types",
 				ElementType: types.Int64Type,
 				Required:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -61,13 +74,13 @@ func (r *channelResource) Configure(_ context.Context, req resource.ConfigureReq
 		return
 	}
 
-	client, ok := req.ProviderData.(*adzerk.ClientWithResponses)
+	providerData, ok := req.ProviderData.(*kevelProviderData)
 	if !ok {
 		resp.Diagnostics.AddError("Error", "Could not get client from provider data")
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
 }
 
 func (r *channelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -78,6 +91,15 @@ func (r *channelResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	requestBody := plan.createRequestBody(ctx, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
@@ -92,7 +114,13 @@ func (r *channelResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	resp.Diagnostics.Append(checkResponse(response.StatusCode(), response.Body, "Error creating channel", "Could not create channel", channelResponseFields)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(setStateWithChannel(&resp.State, ctx, response.JSON200)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeouts"), plan.Timeouts)...)
 }
 
 func (r *channelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -103,6 +131,15 @@ func (r *channelResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	response, err := r.client.GetChannelWithResponse(ctx, int32(state.Id.ValueInt64()))
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -112,6 +149,21 @@ func (r *channelResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	if isNotFoundResponse(response.StatusCode(), response.Body) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(checkResponse(response.StatusCode(), response.Body, "Error Reading Kevel Channel", "Could not read channel ID "+state.Id.String(), channelResponseFields)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if channel := response.JSON200; channel != nil && channel.IsDeleted != nil && *channel.IsDeleted {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	resp.Diagnostics.Append(setStateWithChannel(&resp.State, ctx, response.JSON200)...)
 }
 
@@ -123,6 +175,15 @@ func (r *channelResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	requestBody := plan.updateRequestBody(ctx, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
@@ -137,7 +198,13 @@ func (r *channelResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	resp.Diagnostics.Append(checkResponse(response.StatusCode(), response.Body, "Error updating Kevel Channel", "Could not update channel ID "+plan.Id.String(), channelResponseFields)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(setStateWithChannel(&resp.State, ctx, response.JSON200)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeouts"), plan.Timeouts)...)
 }
 
 func (r *channelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -148,6 +215,15 @@ func (r *channelResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	response, err := r.client.DeleteChannelWithResponse(ctx, int32(state.Id.ValueInt64()))
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -157,15 +233,34 @@ func (r *channelResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	if response.StatusCode() != 200 {
-		resp.Diagnostics.AddError(
-			"Error Deleting Kevel Channel",
-			"Could not delete channel ID "+state.Id.String()+", unexpected status code: "+strconv.Itoa(response.StatusCode()),
-		)
+	resp.Diagnostics.Append(checkResponse(response.StatusCode(), response.Body, "Error Deleting Kevel Channel", "Could not delete channel ID "+state.Id.String(), channelResponseFields)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
+// ImportState accepts either a numeric ID or "title:<title>", which must
+// match exactly one channel that is not deleted.
 func (r *channelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	ImportStatePassthroughInt64ID(ctx, path.Root("id"), req, resp)
+	title, found := strings.CutPrefix(req.ID, "title:")
+	if !found {
+		ImportStatePassthroughInt64ID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	channels, err := listAllChannels(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing Kevel Channel",
+			"Could not list channels, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	importStateMatching(ctx, channels,
+		func(channel adzerk.Channel) bool {
+			return channel.Title == title && (channel.IsDeleted == nil || !*channel.IsDeleted)
+		},
+		func(channel adzerk.Channel) int32 { return channel.Id },
+		"channel", "title "+strconv.Quote(title), "Error importing Kevel Channel", req, resp)
 }
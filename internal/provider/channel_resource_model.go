@@ -3,16 +3,24 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	adzerk "github.com/cysp/adzerk-management-sdk-go"
 )
 
+var channelResponseFields = []responseField{
+	newResponseField("Title", path.Root("title")),
+	newResponseField("AdTypes", path.Root("ad_types")),
+}
+
 type channelResourceModel struct {
-	Id      types.Int64  `tfsdk:"id"`
-	Title   types.String `tfsdk:"title"`
-	AdTypes types.List   `tfsdk:"ad_types"`
+	Id       types.Int64    `tfsdk:"id"`
+	Title    types.String   `tfsdk:"title"`
+	AdTypes  types.Set      `tfsdk:"ad_types"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (m *channelResourceModel) createRequestBody(ctx context.Context, diags *diag.Diagnostics) adzerk.CreateChannelJSONRequestBody {
@@ -38,7 +46,7 @@ func (m *channelResourceModel) updateRequestBody(ctx context.Context, diags *dia
 	}
 }
 
-func makeRequestBodyAdTypes(ctx context.Context, model types.List) ([]int32, diag.Diagnostics) {
+func makeRequestBodyAdTypes(ctx context.Context, model types.Set) ([]int32, diag.Diagnostics) {
 	diags := diag.Diagnostics{}
 
 	if model.IsNull() || model.IsUnknown() {
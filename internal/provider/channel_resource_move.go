@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func (r *channelResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: moveChannelResourceState,
+		},
+	}
+}
+
+// moveChannelResourceState moves an adzerk_channel, or a kevel_channel from
+// another provider, into a kevel_channel.
+func moveChannelResourceState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if !isMoveStateSource(req, "channel") {
+		return
+	}
+
+	source := newMovedState(req)
+	id := source.int64("id", true)
+	title := source.string("title")
+	adTypes := source.int64Set("ad_types")
+
+	resp.Diagnostics.Append(source.diagnostics()...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("title"), title)...)
+	resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("ad_types"), adTypes)...)
+}
@@ -1,17 +1,22 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 
 	"github.com/cysp/adzerk-management-sdk-go/testserver"
 )
 
 func TestChannelResource(t *testing.T) {
-	s := testserver.NewHttpTestServer()
+	s := newTestServerWithLists(nil, nil)
 	defer s.Close()
 
 	resource.UnitTest(t, resource.TestCase{
@@ -37,6 +42,12 @@ func TestChannelResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      "kevel_channel.test",
+				ImportState:       true,
+				ImportStateId:     "title:one",
+				ImportStateVerify: true,
+			},
 			// Update and Read testing
 			{
 				Config: testCombinedConfig(
@@ -50,6 +61,18 @@ func TestChannelResource(t *testing.T) {
 					testChannelResourceConfig("three", []int32{123, 234}),
 				),
 			},
+			// Reordering ad types is not a change
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testChannelResourceConfig("three", []int32{234, 123}),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
 			{
 				Config: testCombinedConfig(
 					testProviderConfig(s.URL),
@@ -72,3 +95,63 @@ func testChannelResourceConfig(title string, adTypes []int32) string {
 	adTypesField := fmt.Sprintf(`ad_types = [%s]`, strings.Join(Map(adTypes, func(v int32) string { return fmt.Sprintf("%d", v) }), ", "))
 	return testResourceConfig("channel", titleField, adTypesField)
 }
+
+func TestChannelResourceDisappears(t *testing.T) {
+	s := testserver.NewHttpTestServer()
+	defer s.Close()
+
+	client := testClient(t, s.URL)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testChannelResourceConfig("one", []int32{123}),
+				),
+				Check: testCheckResourceAttrInt32Func("kevel_channel.test", "id", func(id int32) error {
+					_, err := client.DeleteChannelWithResponse(context.Background(), id)
+					return err
+				}),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("kevel_channel.test", plancheck.ResourceActionCreate),
+					},
+				},
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestChannelResourceReadDeleted(t *testing.T) {
+	ctx := context.Background()
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"Id":1,"Title":"one","AdTypes":[123],"CPM":0,"IsDeleted":true}`))
+	}))
+	defer s.Close()
+
+	r, ok := NewChannelResource().(fwresource.ResourceWithConfigure)
+	if !ok {
+		t.Fatal("expected resource to implement ResourceWithConfigure")
+	}
+
+	r.Configure(ctx, fwresource.ConfigureRequest{ProviderData: newKevelProviderData(testClient(t, s.URL))}, &fwresource.ConfigureResponse{})
+
+	state := testUpgradeResourceState(t, r, "kevel_channel", 1, `{"id":1,"title":"one","ad_types":[123],"timeouts":null}`)
+
+	readResponse := fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, &readResponse)
+
+	if readResponse.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", readResponse.Diagnostics)
+	}
+
+	if !readResponse.State.Raw.IsNull() {
+		t.Errorf("expected deleted channel to be removed from state")
+	}
+}
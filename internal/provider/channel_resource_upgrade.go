@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// channelResourceModelV0 is the state of a channel before ad_types became a
+// set, when it was a list in the order returned by Kevel.
+type channelResourceModelV0 struct {
+	Id       types.Int64    `tfsdk:"id"`
+	Title    types.String   `tfsdk:"title"`
+	AdTypes  types.List     `tfsdk:"ad_types"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func channelResourceSchemaV0(ctx context.Context) *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+			},
+			"title": schema.StringAttribute{
+				Required: true,
+			},
+			"ad_types": schema.ListAttribute{
+				ElementType: types.Int64Type,
+				Required:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *channelResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   channelResourceSchemaV0(ctx),
+			StateUpgrader: upgradeChannelResourceStateV0,
+		},
+	}
+}
+
+func upgradeChannelResourceStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior channelResourceModelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	adTypes := types.SetNull(types.Int64Type)
+	if !prior.AdTypes.IsNull() {
+		// A set cannot hold duplicates, which a list could.
+		elements := []attr.Value{}
+		for _, element := range prior.AdTypes.Elements() {
+			if !containsValue(elements, element) {
+				elements = append(elements, element)
+			}
+		}
+
+		var diags diag.Diagnostics
+		adTypes, diags = types.SetValue(types.Int64Type, elements)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, channelResourceModel{
+		Id:       prior.Id,
+		Title:    prior.Title,
+		AdTypes:  adTypes,
+		Timeouts: prior.Timeouts,
+	})...)
+}
+
+func containsValue(values []attr.Value, value attr.Value) bool {
+	for _, v := range values {
+		if v.Equal(value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	adzerk "github.com/cysp/adzerk-management-sdk-go"
+)
+
+var (
+	_ datasource.DataSource              = &channelSiteMapDataSource{}
+	_ datasource.DataSourceWithConfigure = &channelSiteMapDataSource{}
+)
+
+func NewChannelSiteMapDataSource() datasource.DataSource {
+	return &channelSiteMapDataSource{}
+}
+
+type channelSiteMapDataSource struct {
+	client *adzerk.ClientWithResponses
+}
+
+type channelSiteMapDataSourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	ChannelId types.Int64  `tfsdk:"channel_id"`
+	SiteId    types.Int64  `tfsdk:"site_id"`
+	Priority  types.Int64  `tfsdk:"priority"`
+}
+
+func (d *channelSiteMapDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_site_map"
+}
+
+func (d *channelSiteMapDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Kevel Channel Site Map",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Composite identifier of the channel site map",
+				Computed:    true,
+			},
+			"channel_id": schema.Int64Attribute{
+				Description: "Numeric identifier of the channel",
+				Required:    true,
+			},
+			"site_id": schema.Int64Attribute{
+				Description: "Numeric identifier of the site",
+				Required:    true,
+			},
+			"priority": schema.Int64Attribute{
+				Description: "Priority of the channel site map",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *channelSiteMapDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*kevelProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Error", "Could not get client from provider data")
+		return
+	}
+
+	d.client = providerData.client
+}
+
+func (d *channelSiteMapDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config channelSiteMapDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := d.client.GetChannelSiteMapWithResponse(ctx, int32(config.ChannelId.ValueInt64()), int32(config.SiteId.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Kevel Channel Site Map",
+			"Could not read channel site map "+config.ChannelId.String()+":"+config.SiteId.String()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(checkResponse(response.StatusCode(), response.Body, "Error Reading Kevel Channel Site Map", "Could not read channel site map "+config.ChannelId.String()+":"+config.SiteId.String(), nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setStateWithChannelSiteMap(&resp.State, ctx, response.JSON200)...)
+}
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/cysp/adzerk-management-sdk-go/testserver"
+)
+
+func TestChannelSiteMapDataSource(t *testing.T) {
+	s := testserver.NewHttpTestServer()
+	defer s.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testChannelSiteMapResourceConfig(1, 2, 5),
+					testDataSourceConfig("channel_site_map",
+						`channel_id = kevel_channel_site_map.test.channel_id`,
+						`site_id = kevel_channel_site_map.test.site_id`,
+					),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kevel_channel_site_map.test", "id", "1:2"),
+					resource.TestCheckResourceAttr("data.kevel_channel_site_map.test", "priority", "5"),
+				),
+			},
+		},
+	})
+}
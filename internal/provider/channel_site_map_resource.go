@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -15,9 +16,11 @@ import (
 )
 
 var (
-	_ resource.Resource                = &channelSiteMapResource{}
-	_ resource.ResourceWithConfigure   = &channelSiteMapResource{}
-	_ resource.ResourceWithImportState = &channelSiteMapResource{}
+	_ resource.Resource                 = &channelSiteMapResource{}
+	_ resource.ResourceWithConfigure    = &channelSiteMapResource{}
+	_ resource.ResourceWithImportState  = &channelSiteMapResource{}
+	_ resource.ResourceWithUpgradeState = &channelSiteMapResource{}
+	_ resource.ResourceWithMoveState    = &channelSiteMapResource{}
 )
 
 func NewChannelSiteMapResource() resource.Resource {
@@ -32,9 +35,10 @@ func (r *channelSiteMapResource) Metadata(_ context.Context, req resource.Metada
 	resp.TypeName = req.ProviderTypeName + "_channel_site_map"
 }
 
-func (r *channelSiteMapResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *channelSiteMapResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Kevel Channel Site Map",
+		Version:     1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Composite identifier of the channel site map",
@@ -59,6 +63,14 @@ func (r *channelSiteMapResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Required:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -67,13 +79,13 @@ func (r *channelSiteMapResource) Configure(_ context.Context, req resource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*adzerk.ClientWithResponses)
+	providerData, ok := req.ProviderData.(*kevelProviderData)
 	if !ok {
 		resp.Diagnostics.AddError("Error", "Could not get client from provider data")
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
 }
 
 func (r *channelSiteMapResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -84,6 +96,15 @@ func (r *channelSiteMapResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	response, err := r.client.CreateChannelSiteMapWithResponse(ctx, plan.createRequestBody())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -93,7 +114,13 @@ func (r *channelSiteMapResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	resp.Diagnostics.Append(checkResponse(response.StatusCode(), response.Body, "Error creating channel site map", "Could not create channel site map", channelSiteMapResponseFields)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(setStateWithChannelSiteMap(&resp.State, ctx, response.JSON200)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeouts"), plan.Timeouts)...)
 }
 
 func (r *channelSiteMapResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -104,6 +131,15 @@ func (r *channelSiteMapResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	response, err := r.client.GetChannelSiteMapWithResponse(ctx, int32(state.ChannelId.ValueInt64()), int32(state.SiteId.ValueInt64()))
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -113,6 +149,16 @@ func (r *channelSiteMapResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	if isNotFoundResponse(response.StatusCode(), response.Body) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(checkResponse(response.StatusCode(), response.Body, "Error Reading Kevel Channel Site Map", "Could not read channel site map "+state.ChannelId.String()+":"+state.SiteId.String(), channelSiteMapResponseFields)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(setStateWithChannelSiteMap(&resp.State, ctx, response.JSON200)...)
 }
 
@@ -124,6 +170,15 @@ func (r *channelSiteMapResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	response, err := r.client.UpdateChannelSiteMapWithResponse(ctx, plan.updateRequestBody())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -133,7 +188,13 @@ func (r *channelSiteMapResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	resp.Diagnostics.Append(checkResponse(response.StatusCode(), response.Body, "Error Updating Kevel Channel Site Map", "Could not update channel site map "+plan.ChannelId.String()+":"+plan.SiteId.String(), channelSiteMapResponseFields)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(setStateWithChannelSiteMap(&resp.State, ctx, response.JSON200)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeouts"), plan.Timeouts)...)
 }
 
 func (r *channelSiteMapResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -144,6 +205,15 @@ func (r *channelSiteMapResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	response, err := r.client.DeleteChannelSiteMapWithResponse(ctx, int32(state.ChannelId.ValueInt64()), int32(state.SiteId.ValueInt64()))
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -153,11 +223,8 @@ func (r *channelSiteMapResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	if response.StatusCode() != 200 {
-		resp.Diagnostics.AddError(
-			"Error Deleting Kevel Site",
-			"Could not delete channel site map "+state.ChannelId.String()+":"+state.SiteId.String()+", unexpected status code: "+strconv.Itoa(response.StatusCode()),
-		)
+	resp.Diagnostics.Append(checkResponse(response.StatusCode(), response.Body, "Error Deleting Kevel Channel Site Map", "Could not delete channel site map "+state.ChannelId.String()+":"+state.SiteId.String(), channelSiteMapResponseFields)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 }
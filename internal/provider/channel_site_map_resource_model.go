@@ -1,16 +1,25 @@
 package provider
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	adzerk "github.com/cysp/adzerk-management-sdk-go"
 )
 
+var channelSiteMapResponseFields = []responseField{
+	newResponseField("ChannelId", path.Root("channel_id")),
+	newResponseField("SiteId", path.Root("site_id")),
+	newResponseField("Priority", path.Root("priority")),
+}
+
 type channelSiteMapResourceModel struct {
-	Id        types.String `tfsdk:"id"`
-	ChannelId types.Int64  `tfsdk:"channel_id"`
-	SiteId    types.Int64  `tfsdk:"site_id"`
-	Priority  types.Int64  `tfsdk:"priority"`
+	Id        types.String   `tfsdk:"id"`
+	ChannelId types.Int64    `tfsdk:"channel_id"`
+	SiteId    types.Int64    `tfsdk:"site_id"`
+	Priority  types.Int64    `tfsdk:"priority"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (m *channelSiteMapResourceModel) createRequestBody() adzerk.CreateChannelSiteMapJSONRequestBody {
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func (r *channelSiteMapResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: moveChannelSiteMapResourceState,
+		},
+	}
+}
+
+// moveChannelSiteMapResourceState moves an adzerk_channel_site_map, or a
+// kevel_channel_site_map from another provider, into a
+// kevel_channel_site_map. The identifier is rebuilt from the channel and site
+// rather than copied, as other providers format it differently.
+func moveChannelSiteMapResourceState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if !isMoveStateSource(req, "channel_site_map") {
+		return
+	}
+
+	source := newMovedState(req)
+	channelId := source.int64("channel_id", true)
+	siteId := source.int64("site_id", true)
+	priority := source.int64("priority", false)
+
+	resp.Diagnostics.Append(source.diagnostics()...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := fmt.Sprintf("%d:%d", channelId.ValueInt64(), siteId.ValueInt64())
+
+	resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("channel_id"), channelId)...)
+	resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("site_id"), siteId)...)
+	resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("priority"), priority)...)
+}
@@ -1,11 +1,13 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	"github.com/cysp/adzerk-management-sdk-go/testserver"
 )
@@ -68,3 +70,33 @@ func testChannelSiteMapResourceConfig(channelId int32, siteId int32, priority in
 	priorityField := fmt.Sprintf(`priority = %d`, priority)
 	return testResourceConfig("channel_site_map", channelIdField, siteIdField, priorityField)
 }
+
+func TestChannelSiteMapResourceDisappears(t *testing.T) {
+	s := testserver.NewHttpTestServer()
+	defer s.Close()
+
+	client := testClient(t, s.URL)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testChannelSiteMapResourceConfig(1, 2, 5),
+				),
+				Check: func(_ *terraform.State) error {
+					_, err := client.DeleteChannelSiteMapWithResponse(context.Background(), 1, 2)
+					return err
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("kevel_channel_site_map.test", plancheck.ResourceActionCreate),
+					},
+				},
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// channelSiteMapResourceModelV0 is the state of a channel site map before the
+// timeouts block was added.
+type channelSiteMapResourceModelV0 struct {
+	Id        types.String `tfsdk:"id"`
+	ChannelId types.Int64  `tfsdk:"channel_id"`
+	SiteId    types.Int64  `tfsdk:"site_id"`
+	Priority  types.Int64  `tfsdk:"priority"`
+}
+
+func channelSiteMapResourceSchemaV0() *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"channel_id": schema.Int64Attribute{
+				Required: true,
+			},
+			"site_id": schema.Int64Attribute{
+				Required: true,
+			},
+			"priority": schema.Int64Attribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *channelSiteMapResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   channelSiteMapResourceSchemaV0(),
+			StateUpgrader: upgradeChannelSiteMapResourceStateV0,
+		},
+	}
+}
+
+func upgradeChannelSiteMapResourceStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior channelSiteMapResourceModelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeoutsValue, diags := nullTimeouts(ctx, resp.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, channelSiteMapResourceModel{
+		Id:        prior.Id,
+		ChannelId: prior.ChannelId,
+		SiteId:    prior.SiteId,
+		Priority:  prior.Priority,
+		Timeouts:  timeoutsValue,
+	})...)
+}
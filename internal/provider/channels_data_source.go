@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	adzerk "github.com/cysp/adzerk-management-sdk-go"
+)
+
+var (
+	_ datasource.DataSource              = &channelsDataSource{}
+	_ datasource.DataSourceWithConfigure = &channelsDataSource{}
+)
+
+func NewChannelsDataSource() datasource.DataSource {
+	return &channelsDataSource{}
+}
+
+type channelsDataSource struct {
+	client *adzerk.ClientWithResponses
+}
+
+type channelsDataSourceModel struct {
+	TitleRegex types.String                  `tfsdk:"title_regex"`
+	AdType     types.Int64                   `tfsdk:"ad_type"`
+	IsDeleted  types.Bool                    `tfsdk:"is_deleted"`
+	Channels   []channelsDataSourceItemModel `tfsdk:"channels"`
+}
+
+type channelsDataSourceItemModel struct {
+	Id        types.Int64  `tfsdk:"id"`
+	Title     types.String `tfsdk:"title"`
+	AdTypes   []int64      `tfsdk:"ad_types"`
+	IsDeleted types.Bool   `tfsdk:"is_deleted"`
+}
+
+func (d *channelsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channels"
+}
+
+func (d *channelsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Kevel Channels",
+		Attributes: map[string]schema.Attribute{
+			"title_regex": schema.StringAttribute{
+				Description: "Regular expression that channel titles must match",
+				Optional:    true,
+			},
+			"ad_type": schema.Int64Attribute{
+				Description: "Numeric identifier of an ad type that channels must include",
+				Optional:    true,
+			},
+			"is_deleted": schema.BoolAttribute{
+				Description: "Deletion state that channels must match. Defaults to false",
+				Optional:    true,
+			},
+			"channels": schema.ListNestedAttribute{
+				Description: "List of matching channels",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "Numeric identifier of the channel",
+							Computed:    true,
+						},
+						"title": schema.StringAttribute{
+							Description: "Title of the channel",
+							Computed:    true,
+						},
+						"ad_types": schema.SetAttribute{
+							Description: "Set of ad types",
+							ElementType: types.Int64Type,
+							Computed:    true,
+						},
+						"is_deleted": schema.BoolAttribute{
+							Description: "Whether the channel is deleted",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *channelsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*kevelProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Error", "Could not get client from provider data")
+		return
+	}
+
+	d.client = providerData.client
+}
+
+func (d *channelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data channelsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	titleRegexp := compileRegexpAttribute(path.Root("title_regex"), data.TitleRegex, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channels, err := listAllChannels(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Kevel Channels",
+			"Could not list channels, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	isDeleted := data.IsDeleted.ValueBool()
+
+	data.Channels = []channelsDataSourceItemModel{}
+	for _, channel := range channels {
+		channelIsDeleted := channel.IsDeleted != nil && *channel.IsDeleted
+		if channelIsDeleted != isDeleted {
+			continue
+		}
+		if titleRegexp != nil && !titleRegexp.MatchString(channel.Title) {
+			continue
+		}
+		if !data.AdType.IsNull() && !containsAdType(channel.AdTypes, data.AdType.ValueInt64()) {
+			continue
+		}
+
+		data.Channels = append(data.Channels, channelsDataSourceItemModel{
+			Id:        types.Int64Value(int64(channel.Id)),
+			Title:     types.StringValue(channel.Title),
+			AdTypes:   Map(channel.AdTypes, func(v int32) int64 { return int64(v) }),
+			IsDeleted: types.BoolValue(channelIsDeleted),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func containsAdType(adTypes []int32, adType int64) bool {
+	for _, v := range adTypes {
+		if int64(v) == adType {
+			return true
+		}
+	}
+	return false
+}
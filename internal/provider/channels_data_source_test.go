@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	adzerk "github.com/cysp/adzerk-management-sdk-go"
+)
+
+func TestChannelsDataSource(t *testing.T) {
+	isDeleted := true
+
+	s := newTestServerWithLists(nil, []adzerk.Channel{
+		{Id: 1, Title: "Homepage", AdTypes: []int32{4, 5}},
+		{Id: 2, Title: "Article", AdTypes: []int32{5}},
+		{Id: 3, Title: "Retired", AdTypes: []int32{4}, IsDeleted: &isDeleted},
+	})
+	defer s.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testDataSourceConfig("channels"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kevel_channels.test", "channels.#", "2"),
+					resource.TestCheckResourceAttr("data.kevel_channels.test", "channels.0.id", "1"),
+					resource.TestCheckResourceAttr("data.kevel_channels.test", "channels.1.id", "2"),
+				),
+			},
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testDataSourceConfig("channels",
+						`ad_type = 4`,
+					),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kevel_channels.test", "channels.#", "1"),
+					resource.TestCheckResourceAttr("data.kevel_channels.test", "channels.0.id", "1"),
+					resource.TestCheckResourceAttr("data.kevel_channels.test", "channels.0.ad_types.#", "2"),
+				),
+			},
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testDataSourceConfig("channels",
+						`title_regex = "^Art"`,
+					),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kevel_channels.test", "channels.#", "1"),
+					resource.TestCheckResourceAttr("data.kevel_channels.test", "channels.0.id", "2"),
+					resource.TestCheckResourceAttr("data.kevel_channels.test", "channels.0.title", "Article"),
+				),
+			},
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testDataSourceConfig("channels",
+						`ad_type = 4`,
+						`is_deleted = true`,
+					),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kevel_channels.test", "channels.#", "1"),
+					resource.TestCheckResourceAttr("data.kevel_channels.test", "channels.0.id", "3"),
+					resource.TestCheckResourceAttr("data.kevel_channels.test", "channels.0.is_deleted", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const defaultCredentialsProfile = "default"
+
+// kevelCredentialsProfile holds the settings from one named profile of a
+// credentials file.
+type kevelCredentialsProfile struct {
+	ApiKey     string
+	ApiBaseUrl string
+	NetworkId  *int64
+}
+
+// defaultCredentialsFile returns the path of the credentials file read when
+// none is configured, ~/.kevel/credentials.
+func defaultCredentialsFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(home, ".kevel", "credentials")
+}
+
+// loadCredentialsProfile reads the named profile from a credentials file.
+// It returns nil without an error if the profile does not exist, or if the
+// file does not exist and is optional, as the default credentials file is.
+func loadCredentialsProfile(filename string, profile string, optional bool) (*kevelCredentialsProfile, error) {
+	f, err := os.Open(filename)
+	if optional && errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	profiles, err := parseCredentials(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+
+	return profiles[profile], nil
+}
+
+// parseCredentials parses an INI style credentials file, in which each
+// [profile] section sets api_key, api_base_url and network_id. The network_id
+// is informational only: it is logged to show which network a profile is for
+// but is not sent to Kevel, which infers the network from the API key.
+//
+//	[default]
+//	api_key = ...
+//
+//	[staging]
+//	api_key = ...
+//	network_id = 1234
+func parseCredentials(r io.Reader) (map[string]*kevelCredentialsProfile, error) {
+	profiles := map[string]*kevelCredentialsProfile{}
+
+	var current *kevelCredentialsProfile
+
+	scanner := bufio.NewScanner(r)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if name == "" {
+				return nil, fmt.Errorf("line %d: empty profile name", lineNumber)
+			}
+
+			current = profiles[name]
+			if current == nil {
+				current = &kevelCredentialsProfile{}
+				profiles[name] = current
+			}
+			continue
+		}
+
+		key, value, found := strings.Cut(line, "=")
+		if !found {
+			return nil, fmt.Errorf("line %d: expected a [profile] header or key = value", lineNumber)
+		}
+		if current == nil {
+			return nil, fmt.Errorf("line %d: %s is not within a [profile] section", lineNumber, strings.TrimSpace(key))
+		}
+
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "api_key":
+			current.ApiKey = value
+		case "api_base_url":
+			current.ApiBaseUrl = value
+		case "network_id":
+			networkId, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: network_id must be an integer", lineNumber)
+			}
+			current.NetworkId = &networkId
+		default:
+			return nil, fmt.Errorf("line %d: unknown key %q", lineNumber, key)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
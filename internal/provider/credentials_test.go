@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseCredentials(t *testing.T) {
+	profiles, err := parseCredentials(strings.NewReader(`
+# shared credentials
+[default]
+api_key = default-key
+
+[staging]
+api_key=staging-key
+api_base_url = https://staging.example.org/
+network_id = 1234
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(profiles) != 2 {
+		t.Fatalf("expected 2 profiles, got %d", len(profiles))
+	}
+
+	if profiles["default"].ApiKey != "default-key" || profiles["default"].NetworkId != nil {
+		t.Errorf("unexpected default profile: %+v", profiles["default"])
+	}
+
+	staging := profiles["staging"]
+	if staging.ApiKey != "staging-key" || staging.ApiBaseUrl != "https://staging.example.org/" || staging.NetworkId == nil || *staging.NetworkId != 1234 {
+		t.Errorf("unexpected staging profile: %+v", staging)
+	}
+}
+
+func TestParseCredentialsErrors(t *testing.T) {
+	tests := map[string]string{
+		"key outside profile": "api_key = key\n",
+		"empty profile name":  "[]\n",
+		"missing value":       "[default]\napi_key\n",
+		"unknown key":         "[default]\napi_secret = key\n",
+		"invalid network id":  "[default]\nnetwork_id = abc\n",
+	}
+
+	for name, credentials := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := parseCredentials(strings.NewReader(credentials)); err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
+func testUnsetenv(t *testing.T, keys ...string) {
+	t.Helper()
+
+	for _, key := range keys {
+		t.Setenv(key, "")
+		os.Unsetenv(key)
+	}
+}
+
+func TestResolveCredentials(t *testing.T) {
+	credentialsFile := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(credentialsFile, []byte(`
+[default]
+api_key = default-key
+
+[staging]
+api_key = staging-key
+api_base_url = https://staging.example.org/
+`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		data            KevelProviderModel
+		env             map[string]string
+		expectedBaseUrl string
+		expectedApiKey  string
+		expectWarning   bool
+		expectError     bool
+	}{
+		"default profile": {
+			expectedBaseUrl: "https://api.kevel.co/",
+			expectedApiKey:  "default-key",
+		},
+		"profile attribute": {
+			data:            KevelProviderModel{Profile: types.StringValue("staging")},
+			expectedBaseUrl: "https://staging.example.org/",
+			expectedApiKey:  "staging-key",
+		},
+		"profile environment variable": {
+			env:             map[string]string{"KEVEL_PROFILE": "staging"},
+			expectedBaseUrl: "https://staging.example.org/",
+			expectedApiKey:  "staging-key",
+		},
+		"profile attribute overrides environment variable": {
+			data:            KevelProviderModel{Profile: types.StringValue("default")},
+			env:             map[string]string{"KEVEL_PROFILE": "staging"},
+			expectedBaseUrl: "https://api.kevel.co/",
+			expectedApiKey:  "default-key",
+		},
+		"selected profile overrides environment variables": {
+			env:             map[string]string{"KEVEL_PROFILE": "staging", "KEVEL_API_KEY": "env-key", "KEVEL_API_BASE_URL": "https://env.example.org/"},
+			expectedBaseUrl: "https://staging.example.org/",
+			expectedApiKey:  "staging-key",
+		},
+		"profile attribute overrides environment variables": {
+			data:            KevelProviderModel{Profile: types.StringValue("default")},
+			env:             map[string]string{"KEVEL_API_KEY": "env-key", "KEVEL_API_BASE_URL": "https://env.example.org/"},
+			expectedBaseUrl: "https://env.example.org/",
+			expectedApiKey:  "default-key",
+		},
+		"environment variables override default profile with a warning": {
+			env:             map[string]string{"KEVEL_API_KEY": "env-key", "KEVEL_API_BASE_URL": "https://env.example.org/"},
+			expectedBaseUrl: "https://env.example.org/",
+			expectedApiKey:  "env-key",
+			expectWarning:   true,
+		},
+		"attributes override environment variables": {
+			data:            KevelProviderModel{ApiKey: types.StringValue("config-key"), ApiBaseUrl: types.StringValue("https://config.example.org/")},
+			env:             map[string]string{"KEVEL_API_KEY": "env-key", "KEVEL_API_BASE_URL": "https://env.example.org/"},
+			expectedBaseUrl: "https://config.example.org/",
+			expectedApiKey:  "config-key",
+		},
+		"missing profile": {
+			data:        KevelProviderModel{Profile: types.StringValue("production")},
+			expectError: true,
+		},
+		"missing credentials file attribute": {
+			data:        KevelProviderModel{ApiKey: types.StringValue("config-key"), CredentialsFile: types.StringValue(filepath.Join(t.TempDir(), "missing"))},
+			expectError: true,
+		},
+		"missing credentials file environment variable": {
+			data:        KevelProviderModel{ApiKey: types.StringValue("config-key")},
+			env:         map[string]string{"KEVEL_CREDENTIALS_FILE": filepath.Join(t.TempDir(), "missing")},
+			expectError: true,
+		},
+		"missing default credentials file": {
+			data:            KevelProviderModel{ApiKey: types.StringValue("config-key")},
+			env:             map[string]string{"KEVEL_CREDENTIALS_FILE": "", "HOME": t.TempDir()},
+			expectedBaseUrl: "https://api.kevel.co/",
+			expectedApiKey:  "config-key",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			testUnsetenv(t, "KEVEL_PROFILE", "KEVEL_API_KEY", "KEVEL_API_BASE_URL")
+			t.Setenv("KEVEL_CREDENTIALS_FILE", credentialsFile)
+			for key, value := range test.env {
+				t.Setenv(key, value)
+			}
+
+			diags := diag.Diagnostics{}
+			apiBaseUrl, apiKey := resolveCredentials(context.Background(), test.data, &diags)
+
+			if test.expectError {
+				if !diags.HasError() {
+					t.Errorf("expected an error diagnostic")
+				}
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if hasWarning := diags.WarningsCount() > 0; hasWarning != test.expectWarning {
+				t.Errorf("expected warning %t, got diagnostics: %v", test.expectWarning, diags)
+			}
+
+			if apiBaseUrl != test.expectedBaseUrl {
+				t.Errorf("expected api base url %q, got %q", test.expectedBaseUrl, apiBaseUrl)
+			}
+			if apiKey != test.expectedApiKey {
+				t.Errorf("expected api key %q, got %q", test.expectedApiKey, apiKey)
+			}
+		})
+	}
+}
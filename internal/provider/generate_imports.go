@@ -0,0 +1,292 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/oapi-codegen/oapi-codegen/v2/pkg/securityprovider"
+	"github.com/zclconf/go-cty/cty"
+
+	adzerk "github.com/cysp/adzerk-management-sdk-go"
+)
+
+// GenerateImportsOptions selects the credentials used by GenerateImports.
+// Empty fields fall back to the environment and the credentials file in the
+// same way as the provider configuration.
+type GenerateImportsOptions struct {
+	ApiBaseUrl      string
+	Profile         string
+	CredentialsFile string
+	Version         string
+}
+
+// GenerateImports writes an import block and matching resource configuration
+// for every ad type, site, channel and channel site map in a network, so that
+// an existing network can be brought under management in a single apply.
+// Deleted sites and channels, and channel site maps referring to them, are
+// left out.
+func GenerateImports(ctx context.Context, w io.Writer, options GenerateImportsOptions) error {
+	client, err := newGenerateImportsClient(ctx, options)
+	if err != nil {
+		return err
+	}
+
+	adTypes, err := listAllAdTypes(ctx, client)
+	if err != nil {
+		return fmt.Errorf("could not list ad types: %w", err)
+	}
+
+	sites, err := listAllSites(ctx, client)
+	if err != nil {
+		return fmt.Errorf("could not list sites: %w", err)
+	}
+
+	channels, err := listAllChannels(ctx, client)
+	if err != nil {
+		return fmt.Errorf("could not list channels: %w", err)
+	}
+
+	channelSiteMaps, err := listAllChannelSiteMaps(ctx, client)
+	if err != nil {
+		return fmt.Errorf("could not list channel site maps: %w", err)
+	}
+
+	_, err = w.Write(generateImportsConfig(adTypes, sites, channels, channelSiteMaps))
+	return err
+}
+
+// newGenerateImportsClient builds a client that can only read from Kevel,
+// resolving credentials as the provider does.
+func newGenerateImportsClient(ctx context.Context, options GenerateImportsOptions) (*adzerk.ClientWithResponses, error) {
+	data := KevelProviderModel{}
+	if options.ApiBaseUrl != "" {
+		data.ApiBaseUrl = types.StringValue(options.ApiBaseUrl)
+	}
+	if options.Profile != "" {
+		data.Profile = types.StringValue(options.Profile)
+	}
+	if options.CredentialsFile != "" {
+		data.CredentialsFile = types.StringValue(options.CredentialsFile)
+	}
+
+	diags := diag.Diagnostics{}
+
+	apiBaseUrl, apiKey := resolveCredentials(ctx, data, &diags)
+	if diags.HasError() {
+		return nil, diagnosticsError(diags)
+	}
+
+	var transport http.RoundTripper = newBaseTransport(types.StringNull(), types.StringNull(), types.BoolNull(), &diags)
+	transport = newRetryTransport(transport, defaultMaxRetries, defaultRetryMinWait, defaultRetryMaxWait)
+	transport = newReadOnlyTransport(transport)
+
+	apiKeySecurityProvider, err := securityprovider.NewSecurityProviderApiKey("header", "X-Adzerk-ApiKey", apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := adzerk.NewClientWithResponses(apiBaseUrl,
+		adzerk.WithHTTPClient(&http.Client{Transport: transport}),
+		adzerk.WithRequestEditorFn(apiKeySecurityProvider.Intercept),
+		adzerk.WithRequestEditorFn(userAgentRequestEditor(userAgent(options.Version, "", ""))),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	diags.Append(verifyCredentials(ctx, client)...)
+	if diags.HasError() {
+		return nil, diagnosticsError(diags)
+	}
+
+	return client, nil
+}
+
+// diagnosticsError joins the error diagnostics into a single error for
+// callers outside of Terraform.
+func diagnosticsError(diags diag.Diagnostics) error {
+	errs := []error{}
+	for _, d := range diags.Errors() {
+		errs = append(errs, errors.New(d.Summary()+": "+d.Detail()))
+	}
+
+	return errors.Join(errs...)
+}
+
+// generateImportsConfig renders the import blocks and resources for the given
+// objects, ordered by identifier. Channels and channel site maps refer to the
+// generated ad types, sites and channels rather than repeating their IDs.
+func generateImportsConfig(adTypes []adzerk.AdType, sites []adzerk.Site, channels []adzerk.Channel, channelSiteMaps []adzerk.ChannelSiteMap) []byte {
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+
+	slices.SortFunc(adTypes, func(a, b adzerk.AdType) int { return int(a.Id - b.Id) })
+	slices.SortFunc(sites, func(a, b adzerk.Site) int { return int(a.Id - b.Id) })
+	slices.SortFunc(channels, func(a, b adzerk.Channel) int { return int(a.Id - b.Id) })
+	slices.SortFunc(channelSiteMaps, func(a, b adzerk.ChannelSiteMap) int {
+		if a.ChannelId != b.ChannelId {
+			return int(a.ChannelId - b.ChannelId)
+		}
+		return int(a.SiteId - b.SiteId)
+	})
+
+	adTypeNames := newGeneratedResourceNames()
+	for _, adType := range adTypes {
+		label := fmt.Sprintf("%dx%d", adType.Width, adType.Height)
+		if adType.Name != nil && *adType.Name != "" {
+			label = *adType.Name
+		}
+
+		name := adTypeNames.add(adType.Id, "ad_type", label)
+		resourceBody := appendImportAndResourceBlocks(body, "kevel_ad_type", name, strconv.Itoa(int(adType.Id)))
+		if adType.Name != nil {
+			resourceBody.SetAttributeValue("name", cty.StringVal(*adType.Name))
+		}
+		resourceBody.SetAttributeValue("width", cty.NumberIntVal(int64(adType.Width)))
+		resourceBody.SetAttributeValue("height", cty.NumberIntVal(int64(adType.Height)))
+	}
+
+	siteNames := newGeneratedResourceNames()
+	for _, site := range sites {
+		if site.IsDeleted != nil && *site.IsDeleted {
+			continue
+		}
+
+		name := siteNames.add(site.Id, "site", site.Title)
+		resourceBody := appendImportAndResourceBlocks(body, "kevel_site", name, strconv.Itoa(int(site.Id)))
+		resourceBody.SetAttributeValue("title", cty.StringVal(site.Title))
+		resourceBody.SetAttributeValue("url", cty.StringVal(site.Url))
+	}
+
+	channelNames := newGeneratedResourceNames()
+	for _, channel := range channels {
+		if channel.IsDeleted != nil && *channel.IsDeleted {
+			continue
+		}
+
+		channelAdTypes := slices.Clone(channel.AdTypes)
+		slices.Sort(channelAdTypes)
+
+		adTypeTokens := []hclwrite.Tokens{}
+		for _, adTypeId := range channelAdTypes {
+			adTypeTokens = append(adTypeTokens, adTypeNames.reference("kevel_ad_type", adTypeId))
+		}
+
+		name := channelNames.add(channel.Id, "channel", channel.Title)
+		resourceBody := appendImportAndResourceBlocks(body, "kevel_channel", name, strconv.Itoa(int(channel.Id)))
+		resourceBody.SetAttributeValue("title", cty.StringVal(channel.Title))
+		resourceBody.SetAttributeRaw("ad_types", hclwrite.TokensForTuple(adTypeTokens))
+	}
+
+	channelSiteMapNames := newGeneratedResourceNames()
+	for _, channelSiteMap := range channelSiteMaps {
+		channelName, channelFound := channelNames.ids[channelSiteMap.ChannelId]
+		siteName, siteFound := siteNames.ids[channelSiteMap.SiteId]
+		if !channelFound || !siteFound {
+			continue
+		}
+
+		name := channelSiteMapNames.unique("channel_site_map", channelName+"_"+siteName, fmt.Sprintf("%d_%d", channelSiteMap.ChannelId, channelSiteMap.SiteId))
+		resourceBody := appendImportAndResourceBlocks(body, "kevel_channel_site_map", name, fmt.Sprintf("%d:%d", channelSiteMap.ChannelId, channelSiteMap.SiteId))
+		resourceBody.SetAttributeRaw("channel_id", channelNames.reference("kevel_channel", channelSiteMap.ChannelId))
+		resourceBody.SetAttributeRaw("site_id", siteNames.reference("kevel_site", channelSiteMap.SiteId))
+		// Leave priority out rather than guessing one, so that the required
+		// attribute has to be filled in before the configuration is applied.
+		if channelSiteMap.Priority != nil {
+			resourceBody.SetAttributeValue("priority", cty.NumberIntVal(int64(*channelSiteMap.Priority)))
+		}
+	}
+
+	return hclwrite.Format(f.Bytes())
+}
+
+// appendImportAndResourceBlocks appends an import block for the object with
+// the given ID followed by an empty resource block, returning the body of the
+// resource block for its attributes to be set.
+func appendImportAndResourceBlocks(body *hclwrite.Body, resourceType string, name string, id string) *hclwrite.Body {
+	if len(body.Blocks()) > 0 {
+		body.AppendNewline()
+	}
+
+	importBody := body.AppendNewBlock("import", nil).Body()
+	importBody.SetAttributeTraversal("to", hcl.Traversal{hcl.TraverseRoot{Name: resourceType}, hcl.TraverseAttr{Name: name}})
+	importBody.SetAttributeValue("id", cty.StringVal(id))
+
+	body.AppendNewline()
+
+	return body.AppendNewBlock("resource", []string{resourceType, name}).Body()
+}
+
+var generatedResourceNameInvalidRegExp = regexp.MustCompile("[^a-z0-9]+")
+
+var generatedResourceNameStartRegExp = regexp.MustCompile("^[a-z]")
+
+// generatedResourceNames keeps the resource names generated for one resource
+// type unique, and maps object IDs to the names generated for them.
+type generatedResourceNames struct {
+	ids   map[int32]string
+	taken map[string]bool
+}
+
+func newGeneratedResourceNames() *generatedResourceNames {
+	return &generatedResourceNames{
+		ids:   map[int32]string{},
+		taken: map[string]bool{},
+	}
+}
+
+// add derives a unique resource name for the object with the given ID and
+// records it for references to the object.
+func (n *generatedResourceNames) add(id int32, kind string, title string) string {
+	name := n.unique(kind, title, strconv.Itoa(int(id)))
+	n.ids[id] = name
+
+	return name
+}
+
+// unique derives a resource name from a title, prefixed with the resource
+// kind if the title does not start with a letter. A name that is already
+// taken is suffixed with the given identifier and then, if that is taken
+// too, with a counter.
+func (n *generatedResourceNames) unique(kind string, title string, suffix string) string {
+	base := generatedResourceNameInvalidRegExp.ReplaceAllString(strings.ToLower(title), "_")
+	base = strings.Trim(base, "_")
+
+	if !generatedResourceNameStartRegExp.MatchString(base) {
+		base = strings.TrimSuffix(kind+"_"+base, "_")
+	}
+
+	name := base
+	for i := 1; n.taken[name]; i++ {
+		name = base + "_" + suffix
+		if i > 1 {
+			name += "_" + strconv.Itoa(i)
+		}
+	}
+
+	n.taken[name] = true
+
+	return name
+}
+
+// reference returns an expression referring to the id of the generated
+// resource for the object, or the literal ID if no resource was generated.
+func (n *generatedResourceNames) reference(resourceType string, id int32) hclwrite.Tokens {
+	name, found := n.ids[id]
+	if !found {
+		return hclwrite.TokensForValue(cty.NumberIntVal(int64(id)))
+	}
+
+	return hclwrite.TokensForTraversal(hcl.Traversal{hcl.TraverseRoot{Name: resourceType}, hcl.TraverseAttr{Name: name}, hcl.TraverseAttr{Name: "id"}})
+}
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func testGenerateImportsServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	responses := map[string]string{
+		"/v1/adtypes":     `{"items":[{"Id":5,"Name":"Medium Rectangle","Width":300,"Height":250},{"Id":4,"Width":728,"Height":90}],"page":1,"pageSize":500,"totalItems":2,"totalPages":1}`,
+		"/v1/site":        `{"items":[{"Id":11,"Title":"Example","Url":"https://example.org/"},{"Id":12,"Title":"Example","Url":"https://example.com/"},{"Id":13,"Title":"Gone","Url":"https://gone.example.org/","IsDeleted":true},{"Id":5,"Title":"Example 12","Url":"https://example.net/"},{"Id":14,"Title":"Top","Url":"https://top.example.org/"},{"Id":15,"Title":"Example Top","Url":"https://example.org/top"}],"page":1,"pageSize":500,"totalItems":6,"totalPages":1}`,
+		"/v1/channel":     `{"ChannelIds":[21,22]}`,
+		"/v1/channel/21":  `{"Id":21,"Title":"Homepage","AdTypes":[5,4,99],"CPM":0}`,
+		"/v1/channel/22":  `{"Id":22,"Title":"Homepage Example","AdTypes":[],"CPM":0}`,
+		"/v1/channelSite": `{"items":[{"ChannelId":21,"SiteId":12,"Priority":2},{"ChannelId":21,"SiteId":13,"Priority":1},{"ChannelId":21,"SiteId":15,"Priority":1},{"ChannelId":22,"SiteId":11},{"ChannelId":22,"SiteId":14,"Priority":1}],"page":1,"pageSize":500,"totalItems":5,"totalPages":1}`,
+	}
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.Header.Get("X-Adzerk-ApiKey") != "test-key" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		response, found := responses[r.URL.Path]
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func TestGenerateImports(t *testing.T) {
+	s := testGenerateImportsServer(t)
+
+	testUnsetenv(t, "KEVEL_PROFILE", "KEVEL_API_BASE_URL", "KEVEL_CREDENTIALS_FILE")
+	t.Setenv("KEVEL_API_KEY", "test-key")
+	t.Setenv("HOME", t.TempDir())
+
+	var output bytes.Buffer
+	if err := GenerateImports(context.Background(), &output, GenerateImportsOptions{ApiBaseUrl: s.URL, Version: "test"}); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `import {
+  to = kevel_ad_type.ad_type_728x90
+  id = "4"
+}
+
+resource "kevel_ad_type" "ad_type_728x90" {
+  width  = 728
+  height = 90
+}
+
+import {
+  to = kevel_ad_type.medium_rectangle
+  id = "5"
+}
+
+resource "kevel_ad_type" "medium_rectangle" {
+  name   = "Medium Rectangle"
+  width  = 300
+  height = 250
+}
+
+import {
+  to = kevel_site.example_12
+  id = "5"
+}
+
+resource "kevel_site" "example_12" {
+  title = "Example 12"
+  url   = "https://example.net/"
+}
+
+import {
+  to = kevel_site.example
+  id = "11"
+}
+
+resource "kevel_site" "example" {
+  title = "Example"
+  url   = "https://example.org/"
+}
+
+import {
+  to = kevel_site.example_12_2
+  id = "12"
+}
+
+resource "kevel_site" "example_12_2" {
+  title = "Example"
+  url   = "https://example.com/"
+}
+
+import {
+  to = kevel_site.top
+  id = "14"
+}
+
+resource "kevel_site" "top" {
+  title = "Top"
+  url   = "https://top.example.org/"
+}
+
+import {
+  to = kevel_site.example_top
+  id = "15"
+}
+
+resource "kevel_site" "example_top" {
+  title = "Example Top"
+  url   = "https://example.org/top"
+}
+
+import {
+  to = kevel_channel.homepage
+  id = "21"
+}
+
+resource "kevel_channel" "homepage" {
+  title    = "Homepage"
+  ad_types = [kevel_ad_type.ad_type_728x90.id, kevel_ad_type.medium_rectangle.id, 99]
+}
+
+import {
+  to = kevel_channel.homepage_example
+  id = "22"
+}
+
+resource "kevel_channel" "homepage_example" {
+  title    = "Homepage Example"
+  ad_types = []
+}
+
+import {
+  to = kevel_channel_site_map.homepage_example_12_2
+  id = "21:12"
+}
+
+resource "kevel_channel_site_map" "homepage_example_12_2" {
+  channel_id = kevel_channel.homepage.id
+  site_id    = kevel_site.example_12_2.id
+  priority   = 2
+}
+
+import {
+  to = kevel_channel_site_map.homepage_example_top
+  id = "21:15"
+}
+
+resource "kevel_channel_site_map" "homepage_example_top" {
+  channel_id = kevel_channel.homepage.id
+  site_id    = kevel_site.example_top.id
+  priority   = 1
+}
+
+import {
+  to = kevel_channel_site_map.homepage_example_example
+  id = "22:11"
+}
+
+resource "kevel_channel_site_map" "homepage_example_example" {
+  channel_id = kevel_channel.homepage_example.id
+  site_id    = kevel_site.example.id
+}
+
+import {
+  to = kevel_channel_site_map.homepage_example_top_22_14
+  id = "22:14"
+}
+
+resource "kevel_channel_site_map" "homepage_example_top_22_14" {
+  channel_id = kevel_channel.homepage_example.id
+  site_id    = kevel_site.top.id
+  priority   = 1
+}
+`
+
+	if output.String() != expected {
+		t.Errorf("unexpected configuration:\n%s", output.String())
+	}
+}
+
+func TestGenerateImportsInvalidApiKey(t *testing.T) {
+	s := testGenerateImportsServer(t)
+
+	testUnsetenv(t, "KEVEL_PROFILE", "KEVEL_API_BASE_URL", "KEVEL_CREDENTIALS_FILE")
+	t.Setenv("KEVEL_API_KEY", "wrong-key")
+	t.Setenv("HOME", t.TempDir())
+
+	var output bytes.Buffer
+	if err := GenerateImports(context.Background(), &output, GenerateImportsOptions{ApiBaseUrl: s.URL}); err == nil {
+		t.Errorf("expected an error")
+	}
+
+	if output.Len() != 0 {
+		t.Errorf("expected no output, got:\n%s", output.String())
+	}
+}
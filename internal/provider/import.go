@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var importAdTypeSizeRegExp = regexp.MustCompile(`^([0-9]+)x([0-9]+)$`)
+
+// importStateMatching imports the single item that matches an import
+// identifier such as "title:My Site". When the identifier is ambiguous the
+// error lists the ID of every match, so that one can be imported by ID.
+func importStateMatching[T any](ctx context.Context, items []T, match func(T) bool, id func(T) int32, noun string, description string, summary string, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids := []int32{}
+	for _, item := range items {
+		if match(item) {
+			ids = append(ids, id(item))
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		resp.Diagnostics.AddError(
+			summary,
+			fmt.Sprintf("Could not import %q, no %s found with %s", req.ID, noun, description),
+		)
+	case 1:
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), int64(ids[0]))...)
+	default:
+		resp.Diagnostics.AddError(
+			summary,
+			fmt.Sprintf("Could not import %q, %s matched more than one %s (IDs %s); import one of them by ID instead",
+				req.ID, description, noun, strings.Join(Map(ids, func(id int32) string { return strconv.Itoa(int(id)) }), ", ")),
+		)
+	}
+}
@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	adzerk "github.com/cysp/adzerk-management-sdk-go"
+)
+
+const listPageSize int32 = 500
+
+func listAllAdTypes(ctx context.Context, client *adzerk.ClientWithResponses) ([]adzerk.AdType, error) {
+	adTypes := []adzerk.AdType{}
+
+	pageSize := listPageSize
+	for page := int32(1); ; page++ {
+		response, err := client.ListAdTypesWithResponse(ctx, &adzerk.ListAdTypesParams{Page: &page, PageSize: &pageSize})
+		if err != nil {
+			return nil, err
+		}
+
+		if err := responseError(response.StatusCode(), response.Body); err != nil {
+			return nil, err
+		}
+
+		adTypeList := response.JSON200
+		if adTypeList == nil {
+			return nil, errors.New("ad type list is nil, status code: " + strconv.Itoa(response.StatusCode()))
+		}
+
+		adTypes = append(adTypes, adTypeList.Items...)
+
+		if page >= adTypeList.TotalPages || len(adTypeList.Items) == 0 {
+			return adTypes, nil
+		}
+	}
+}
+
+func listAllSites(ctx context.Context, client *adzerk.ClientWithResponses) ([]adzerk.Site, error) {
+	sites := []adzerk.Site{}
+
+	pageSize := listPageSize
+	for page := int32(1); ; page++ {
+		response, err := client.ListSitesWithResponse(ctx, &adzerk.ListSitesParams{Page: &page, PageSize: &pageSize})
+		if err != nil {
+			return nil, err
+		}
+
+		if err := responseError(response.StatusCode(), response.Body); err != nil {
+			return nil, err
+		}
+
+		siteList := response.JSON200
+		if siteList == nil {
+			return nil, errors.New("site list is nil, status code: " + strconv.Itoa(response.StatusCode()))
+		}
+
+		sites = append(sites, siteList.Items...)
+
+		if page >= siteList.TotalPages || len(siteList.Items) == 0 {
+			return sites, nil
+		}
+	}
+}
+
+func listAllChannelSiteMaps(ctx context.Context, client *adzerk.ClientWithResponses) ([]adzerk.ChannelSiteMap, error) {
+	channelSiteMaps := []adzerk.ChannelSiteMap{}
+
+	pageSize := listPageSize
+	for page := int32(1); ; page++ {
+		response, err := client.ListChannelSiteMapsWithResponse(ctx, &adzerk.ListChannelSiteMapsParams{Page: &page, PageSize: &pageSize})
+		if err != nil {
+			return nil, err
+		}
+
+		if err := responseError(response.StatusCode(), response.Body); err != nil {
+			return nil, err
+		}
+
+		channelSiteMapList := response.JSON200
+		if channelSiteMapList == nil {
+			return nil, errors.New("channel site map list is nil, status code: " + strconv.Itoa(response.StatusCode()))
+		}
+
+		channelSiteMaps = append(channelSiteMaps, channelSiteMapList.Items...)
+
+		if page >= channelSiteMapList.TotalPages || len(channelSiteMapList.Items) == 0 {
+			return channelSiteMaps, nil
+		}
+	}
+}
+
+// listAllChannels fetches every channel in the network. The channel list
+// endpoint only returns identifiers and does not report the number of pages,
+// so pages are requested until one comes back empty and each channel is then
+// fetched in turn.
+func listAllChannels(ctx context.Context, client *adzerk.ClientWithResponses) ([]adzerk.Channel, error) {
+	channelIds := []int32{}
+	seen := map[int32]bool{}
+
+	pageSize := listPageSize
+	for page := int32(1); ; page++ {
+		response, err := client.ListChannelsWithResponse(ctx, &adzerk.ListChannelsParams{Page: &page, PageSize: &pageSize})
+		if err != nil {
+			return nil, err
+		}
+
+		if err := responseError(response.StatusCode(), response.Body); err != nil {
+			return nil, err
+		}
+
+		channelList := response.JSON200
+		if channelList == nil {
+			return nil, errors.New("channel list is nil, status code: " + strconv.Itoa(response.StatusCode()))
+		}
+
+		// Stop on a page with no new channels as well as an empty one, in
+		// case the endpoint ignores the page and returns the same channels.
+		found := false
+		for _, channelId := range channelList.ChannelIds {
+			if !seen[channelId] {
+				seen[channelId] = true
+				channelIds = append(channelIds, channelId)
+				found = true
+			}
+		}
+
+		if !found {
+			break
+		}
+	}
+
+	channels := make([]adzerk.Channel, 0, len(channelIds))
+	for _, channelId := range channelIds {
+		channelResponse, err := client.GetChannelWithResponse(ctx, channelId)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := responseError(channelResponse.StatusCode(), channelResponse.Body); err != nil {
+			return nil, err
+		}
+
+		if channelResponse.JSON200 == nil {
+			return nil, errors.New("channel " + strconv.Itoa(int(channelId)) + " is nil, status code: " + strconv.Itoa(channelResponse.StatusCode()))
+		}
+
+		channels = append(channels, *channelResponse.JSON200)
+	}
+
+	return channels, nil
+}
+
+// findUnique returns the single item matching the predicate along with the
+// total number of matches, so that callers can report ambiguous lookups.
+func findUnique[T any](items []T, match func(T) bool) (*T, int) {
+	var found *T
+	count := 0
+	for i := range items {
+		if match(items[i]) {
+			if found == nil {
+				found = &items[i]
+			}
+			count++
+		}
+	}
+	return found, count
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	adzerk "github.com/cysp/adzerk-management-sdk-go"
+)
+
+func TestListAllChannelsPages(t *testing.T) {
+	pages := map[string][]int32{
+		"1": {1, 2},
+		"2": {3},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/channel", func(w http.ResponseWriter, r *http.Request) {
+		channelIds := pages[r.URL.Query().Get("page")]
+		if channelIds == nil {
+			channelIds = []int32{}
+		}
+		testWriteJson(w, adzerk.ChannelList{ChannelIds: channelIds})
+	})
+	mux.HandleFunc("GET /v1/channel/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id, _ := strconv.Atoi(r.PathValue("id"))
+		testWriteJson(w, adzerk.Channel{Id: int32(id), Title: "channel " + r.PathValue("id"), AdTypes: []int32{}})
+	})
+
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	channels, err := listAllChannels(context.Background(), testClient(t, s.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(channels) != 3 || channels[0].Id != 1 || channels[1].Id != 2 || channels[2].Id != 3 {
+		t.Errorf("expected channels 1, 2 and 3, got %v", channels)
+	}
+}
+
+func TestListAllChannelsUnpaged(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/channel", func(w http.ResponseWriter, r *http.Request) {
+		testWriteJson(w, adzerk.ChannelList{ChannelIds: []int32{1}})
+	})
+	mux.HandleFunc("GET /v1/channel/{id}", func(w http.ResponseWriter, r *http.Request) {
+		testWriteJson(w, adzerk.Channel{Id: 1, Title: "one", AdTypes: []int32{}})
+	})
+
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	channels, err := listAllChannels(context.Background(), testClient(t, s.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(channels) != 1 {
+		t.Errorf("expected a single channel, got %v", channels)
+	}
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// isMoveStateSource reports whether a state move comes from the equivalent
+// resource type of an Adzerk provider, or of another Kevel provider, for
+// example "adzerk_site" or "kevel_site" for a typeName of "site".
+func isMoveStateSource(req resource.MoveStateRequest, typeName string) bool {
+	return req.SourceTypeName == "adzerk_"+typeName || req.SourceTypeName == "kevel_"+typeName
+}
+
+// movedState decodes the raw state of a resource from another provider
+// without a schema, accepting integers written as either numbers or strings
+// as SDK based providers write identifiers. The first error encountered is
+// kept and reported by diagnostics.
+type movedState struct {
+	typeName   string
+	attributes map[string]json.RawMessage
+	err        error
+}
+
+func newMovedState(req resource.MoveStateRequest) *movedState {
+	s := &movedState{
+		typeName: req.SourceTypeName,
+	}
+
+	if req.SourceRawState == nil {
+		s.err = errors.New("no source state")
+		return s
+	}
+
+	s.err = json.Unmarshal(req.SourceRawState.JSON, &s.attributes)
+
+	return s
+}
+
+func (s *movedState) fail(key string, err error) {
+	if s.err == nil {
+		s.err = fmt.Errorf("%s: %w", key, err)
+	}
+}
+
+func (s *movedState) raw(key string, required bool) (json.RawMessage, bool) {
+	raw, found := s.attributes[key]
+	if !found || string(raw) == "null" {
+		if required {
+			s.fail(key, errors.New("missing required value"))
+		}
+		return nil, false
+	}
+
+	return raw, true
+}
+
+func (s *movedState) string(key string) types.String {
+	raw, found := s.raw(key, false)
+	if !found {
+		return types.StringNull()
+	}
+
+	var value string
+	if err := json.Unmarshal(raw, &value); err != nil {
+		s.fail(key, fmt.Errorf("expected a string, got %s", raw))
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
+
+func (s *movedState) int64(key string, required bool) types.Int64 {
+	raw, found := s.raw(key, required)
+	if !found {
+		return types.Int64Null()
+	}
+
+	value, err := parseMovedInt64(raw)
+	if err != nil {
+		s.fail(key, err)
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(value)
+}
+
+func (s *movedState) int64Set(key string) types.Set {
+	raw, found := s.raw(key, false)
+	if !found {
+		return types.SetNull(types.Int64Type)
+	}
+
+	var elements []json.RawMessage
+	if err := json.Unmarshal(raw, &elements); err != nil {
+		s.fail(key, fmt.Errorf("expected a list, got %s", raw))
+		return types.SetNull(types.Int64Type)
+	}
+
+	values := []int64{}
+	for _, element := range elements {
+		value, err := parseMovedInt64(element)
+		if err != nil {
+			s.fail(key, err)
+			return types.SetNull(types.Int64Type)
+		}
+
+		if !containsInt64(values, value) {
+			values = append(values, value)
+		}
+	}
+
+	set, diags := types.SetValueFrom(context.Background(), types.Int64Type, values)
+	if diags.HasError() {
+		s.fail(key, errors.New("could not convert to a set"))
+		return types.SetNull(types.Int64Type)
+	}
+
+	return set
+}
+
+func (s *movedState) diagnostics() diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	if s.err != nil {
+		diags.AddError("Unable to Move Resource State", "Could not read "+s.typeName+" state, unexpected error: "+s.err.Error())
+	}
+
+	return diags
+}
+
+func parseMovedInt64(raw json.RawMessage) (int64, error) {
+	var number json.Number
+	if err := json.Unmarshal(raw, &number); err == nil {
+		if value, err := number.Int64(); err == nil {
+			return value, nil
+		}
+	}
+
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		if value, err := strconv.ParseInt(strings.TrimSpace(text), 10, 64); err == nil {
+			return value, nil
+		}
+	}
+
+	return 0, fmt.Errorf("expected an integer, got %s", raw)
+}
+
+func containsInt64(values []int64, value int64) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
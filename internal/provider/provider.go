@@ -2,13 +2,23 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/url"
 	"os"
+	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/oapi-codegen/oapi-codegen/v2/pkg/securityprovider"
 
 	adzerk "github.com/cysp/adzerk-management-sdk-go"
@@ -16,7 +26,8 @@ import (
 
 // Ensure KevelProvider satisfies various provider interfaces.
 var (
-	_ provider.Provider = &KevelProvider{}
+	_ provider.Provider                   = &KevelProvider{}
+	_ provider.ProviderWithValidateConfig = &KevelProvider{}
 )
 
 // KevelProvider defines the provider implementation.
@@ -29,8 +40,21 @@ type KevelProvider struct {
 
 // KevelProviderModel describes the provider data model.
 type KevelProviderModel struct {
-	ApiBaseUrl types.String `tfsdk:"api_base_url"`
-	ApiKey     types.String `tfsdk:"api_key"`
+	ApiBaseUrl            types.String  `tfsdk:"api_base_url"`
+	ApiKey                types.String  `tfsdk:"api_key"`
+	MaxRetries            types.Int64   `tfsdk:"max_retries"`
+	RetryMinWait          types.String  `tfsdk:"retry_min_wait"`
+	RetryMaxWait          types.String  `tfsdk:"retry_max_wait"`
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+	RequestTimeout        types.String  `tfsdk:"request_timeout"`
+	ProxyUrl              types.String  `tfsdk:"proxy_url"`
+	CaCertFile            types.String  `tfsdk:"ca_cert_file"`
+	InsecureSkipVerify    types.Bool    `tfsdk:"insecure_skip_verify"`
+	UserAgent             types.String  `tfsdk:"user_agent"`
+	Profile               types.String  `tfsdk:"profile"`
+	CredentialsFile       types.String  `tfsdk:"credentials_file"`
+	ReadOnly              types.Bool    `tfsdk:"read_only"`
 }
 
 func (p *KevelProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -43,19 +67,80 @@ func (p *KevelProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 		Description: "The \"kevel\" provider allows the configuration of inventory items within the [Kevel](https://www.kevel.com) ad server platform.",
 		Attributes: map[string]schema.Attribute{
 			"api_base_url": schema.StringAttribute{
-				Description: "The base URL of the Kevel API. This can also be set via the KEVEL_API_BASE_URL environment variable.",
+				Description: "The base URL of the Kevel API. This can also be set via the api_base_url of the selected credentials profile or the KEVEL_API_BASE_URL environment variable, with precedence as described for profile.",
 				Optional:    true,
 			},
 			"api_key": schema.StringAttribute{
-				Description: "Your Kevel API Key. This can also be set via the KEVEL_API_KEY environment variable.",
+				Description: "Your Kevel API Key. This can also be set via the api_key of the selected credentials profile or the KEVEL_API_KEY environment variable, with precedence as described for profile.",
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"profile": schema.StringAttribute{
+				Description: "Name of the profile to use from the credentials file. This can also be set via the KEVEL_PROFILE environment variable. Defaults to \"default\", which may be absent from the credentials file; any other profile must exist. The api_key and api_base_url attributes take precedence over everything else. The values of a profile selected here or via KEVEL_PROFILE then take precedence over the KEVEL_API_KEY and KEVEL_API_BASE_URL environment variables, which in turn take precedence over the values of the \"default\" profile, with a warning when both are set.",
+				Optional:    true,
+			},
+			"credentials_file": schema.StringAttribute{
+				Description: "Path to an INI style credentials file with a [section] per profile setting api_key, api_base_url and network_id, where network_id is informational only and is not sent to Kevel. This can also be set via the KEVEL_CREDENTIALS_FILE environment variable, and a file set either way must exist. Defaults to \"~/.kevel/credentials\", which is ignored if it does not exist.",
+				Optional:    true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of times to retry a request that was rate limited or that failed with a server error. Server errors are not retried for create requests. Defaults to 3.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_min_wait": schema.StringAttribute{
+				Description: "Duration to wait before the first retry, doubling with each subsequent retry, for example \"500ms\". Defaults to \"1s\".",
+				Optional:    true,
+			},
+			"retry_max_wait": schema.StringAttribute{
+				Description: "Maximum duration to wait between retries, including waits requested by a Retry-After header. Defaults to \"30s\".",
+				Optional:    true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Description: "Maximum average number of requests per second sent to the Kevel API across all resources and data sources. Unlimited by default.",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: "Maximum number of requests to the Kevel API in flight at once across all resources and data sources. Unlimited by default.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"request_timeout": schema.StringAttribute{
+				Description: "Maximum duration of a request to the Kevel API, including any retries, for example \"2m\". Unlimited by default, leaving resource timeouts to bound each operation.",
+				Optional:    true,
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "URL of the proxy through which to send requests to the Kevel API. Defaults to the proxy configured by the HTTPS_PROXY and NO_PROXY environment variables.",
+				Optional:    true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a file of PEM encoded CA certificates to trust in addition to the system certificate pool when connecting to the Kevel API.",
+				Optional:    true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Skip verification of the Kevel API's TLS certificate, for example when testing against a local stub. Do not enable this against the real Kevel API.",
+				Optional:    true,
+			},
+			"read_only": schema.BoolAttribute{
+				Description: "Refuse to send any request that could modify Kevel, so that creating, updating or deleting a resource fails while reading resources and data sources keeps working. This can also be set via the KEVEL_READ_ONLY environment variable.",
+				Optional:    true,
+			},
+			"user_agent": schema.StringAttribute{
+				Description: "Additional product tokens appended to the User-Agent header sent with each request, which identifies the provider and Terraform versions.",
+				Optional:    true,
+			},
 		},
 	}
 }
 
-func (p *KevelProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+func (p *KevelProvider) ValidateConfig(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
 	var data KevelProviderModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -63,49 +148,260 @@ func (p *KevelProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		return
 	}
 
-	var apiBaseUrl string
-	if !data.ApiBaseUrl.IsNull() {
-		apiBaseUrl = data.ApiBaseUrl.ValueString()
-	} else {
-		kevelApiBaseUrl, found := os.LookupEnv("KEVEL_API_BASE_URL")
-		if found {
-			apiBaseUrl = kevelApiBaseUrl
-		} else {
-			apiBaseUrl = "https://api.kevel.co/"
+	if !data.ApiBaseUrl.IsNull() && !data.ApiBaseUrl.IsUnknown() {
+		if err := validateApiBaseUrl(data.ApiBaseUrl.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("api_base_url"), "Invalid API Base URL", err.Error())
 		}
 	}
 
-	if apiBaseUrl == "" {
-		resp.Diagnostics.AddError("Error configuring client", "No API base URL provided")
+	if !data.ApiKey.IsNull() && !data.ApiKey.IsUnknown() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("api_key"),
+			"API Key in Configuration",
+			"The Kevel API key is set directly in the provider configuration, where it is likely to be committed to version control. "+
+				"Consider setting it via the KEVEL_API_KEY environment variable or a credentials file profile instead.",
+		)
+	}
+}
+
+// validateApiBaseUrl checks that an API base URL is an absolute http or https
+// URL, so that a typo is reported before any request is attempted.
+func validateApiBaseUrl(apiBaseUrl string) error {
+	u, err := url.Parse(apiBaseUrl)
+	if err != nil {
+		return fmt.Errorf("could not parse %q: %w", apiBaseUrl, err)
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("%q must be an absolute URL with an http or https scheme", apiBaseUrl)
+	}
+
+	if u.Host == "" {
+		return fmt.Errorf("%q must include a host", apiBaseUrl)
+	}
+
+	return nil
+}
+
+func (p *KevelProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var data KevelProviderModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var apiKey string
-	if !data.ApiKey.IsNull() {
-		apiKey = data.ApiKey.ValueString()
-	} else {
-		apiKey = os.Getenv("KEVEL_API_KEY")
+	apiBaseUrl, apiKey := resolveCredentials(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if apiKey == "" {
-		resp.Diagnostics.AddError("Error configuring client", "No API key provided")
+	maxRetries := int64(defaultMaxRetries)
+	if !data.MaxRetries.IsNull() {
+		maxRetries = data.MaxRetries.ValueInt64()
+	}
+
+	retryMinWait := parseDurationAttribute(path.Root("retry_min_wait"), data.RetryMinWait, defaultRetryMinWait, &resp.Diagnostics)
+	retryMaxWait := parseDurationAttribute(path.Root("retry_max_wait"), data.RetryMaxWait, defaultRetryMaxWait, &resp.Diagnostics)
+	requestTimeout := parseDurationAttribute(path.Root("request_timeout"), data.RequestTimeout, 0, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if retryMinWait > retryMaxWait {
+		resp.Diagnostics.AddAttributeError(path.Root("retry_min_wait"), "Error configuring client", "retry_min_wait must not be greater than retry_max_wait")
+		return
+	}
+
+	readOnly := false
+	if !data.ReadOnly.IsNull() {
+		readOnly = data.ReadOnly.ValueBool()
+	} else if kevelReadOnly := os.Getenv("KEVEL_READ_ONLY"); kevelReadOnly != "" {
+		var err error
+		readOnly, err = strconv.ParseBool(kevelReadOnly)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("read_only"), "Error configuring client", "Could not parse KEVEL_READ_ONLY environment variable "+strconv.Quote(kevelReadOnly)+" as a boolean")
+			return
+		}
+	}
+
+	baseTransport := newBaseTransport(data.ProxyUrl, data.CaCertFile, data.InsecureSkipVerify, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var transport http.RoundTripper = baseTransport
+	transport = newLoggingTransport(transport)
+	transport = newRateLimitTransport(transport, data.RequestsPerSecond.ValueFloat64(), int(data.MaxConcurrentRequests.ValueInt64()))
+	transport = newRetryTransport(transport, int(maxRetries), retryMinWait, retryMaxWait)
+	if readOnly {
+		transport = newReadOnlyTransport(transport)
+	}
+
+	httpClient := &http.Client{
+		Transport: transport,
+		Timeout:   requestTimeout,
+	}
+
 	apiKeySecurityProvider, err := securityprovider.NewSecurityProviderApiKey("header", "X-Adzerk-ApiKey", apiKey)
 	if err != nil {
 		resp.Diagnostics.AddError("Error configuring client", err.Error())
 		return
 	}
 
-	client, err := adzerk.NewClientWithResponses(apiBaseUrl, adzerk.WithRequestEditorFn(apiKeySecurityProvider.Intercept))
+	client, err := adzerk.NewClientWithResponses(apiBaseUrl,
+		adzerk.WithHTTPClient(httpClient),
+		adzerk.WithRequestEditorFn(apiKeySecurityProvider.Intercept),
+		adzerk.WithRequestEditorFn(userAgentRequestEditor(userAgent(p.version, req.TerraformVersion, data.UserAgent.ValueString()))),
+	)
 	if err != nil {
 		resp.Diagnostics.AddError("Error configuring client", err.Error())
 		return
 	}
 
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	resp.Diagnostics.Append(verifyCredentials(ctx, client)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	providerData := newKevelProviderData(client)
+
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
+}
+
+// resolveCredentials determines the API base URL and API key from, in order of
+// precedence, the provider configuration, the environment and the selected
+// profile of the credentials file.
+func resolveCredentials(ctx context.Context, data KevelProviderModel, diags *diag.Diagnostics) (string, string) {
+	profileName, profileSelected := defaultCredentialsProfile, false
+	if !data.Profile.IsNull() {
+		profileName, profileSelected = data.Profile.ValueString(), true
+	} else if kevelProfile := os.Getenv("KEVEL_PROFILE"); kevelProfile != "" {
+		profileName, profileSelected = kevelProfile, true
+	}
+
+	credentialsFile, credentialsFileOptional := defaultCredentialsFile(), true
+	if !data.CredentialsFile.IsNull() {
+		credentialsFile, credentialsFileOptional = data.CredentialsFile.ValueString(), false
+	} else if kevelCredentialsFile := os.Getenv("KEVEL_CREDENTIALS_FILE"); kevelCredentialsFile != "" {
+		credentialsFile, credentialsFileOptional = kevelCredentialsFile, false
+	}
+
+	var profile *kevelCredentialsProfile
+	if credentialsFile != "" {
+		var err error
+		profile, err = loadCredentialsProfile(credentialsFile, profileName, credentialsFileOptional)
+		if err != nil {
+			diags.AddAttributeError(path.Root("credentials_file"), "Error configuring client", "Could not read credentials file, unexpected error: "+err.Error())
+			return "", ""
+		}
+	}
+
+	if profile == nil {
+		if profileSelected {
+			diags.AddAttributeError(path.Root("profile"), "Error configuring client", fmt.Sprintf("Profile %q not found in credentials file %q", profileName, credentialsFile))
+			return "", ""
+		}
+
+		profile = &kevelCredentialsProfile{}
+	} else {
+		fields := map[string]interface{}{"profile": profileName, "credentials_file": credentialsFile}
+		if profile.NetworkId != nil {
+			fields["network_id"] = *profile.NetworkId
+		}
+		tflog.Debug(ctx, "Using Kevel credentials profile", fields)
+	}
+
+	kevelApiBaseUrl, kevelApiBaseUrlFound := os.LookupEnv("KEVEL_API_BASE_URL")
+
+	var apiBaseUrl string
+	switch {
+	case !data.ApiBaseUrl.IsNull():
+		apiBaseUrl = data.ApiBaseUrl.ValueString()
+	case profileSelected && profile.ApiBaseUrl != "":
+		apiBaseUrl = profile.ApiBaseUrl
+	case kevelApiBaseUrlFound:
+		apiBaseUrl = kevelApiBaseUrl
+		if profile.ApiBaseUrl != "" {
+			addEnvironmentOverridesProfileWarning(diags, "KEVEL_API_BASE_URL", "api_base_url", profileName, credentialsFile)
+		}
+	case profile.ApiBaseUrl != "":
+		apiBaseUrl = profile.ApiBaseUrl
+	default:
+		apiBaseUrl = "https://api.kevel.co/"
+	}
+
+	if apiBaseUrl == "" {
+		diags.AddError("Error configuring client", "No API base URL provided")
+		return "", ""
+	}
+
+	if err := validateApiBaseUrl(apiBaseUrl); err != nil {
+		diags.AddAttributeError(path.Root("api_base_url"), "Error configuring client", err.Error())
+		return "", ""
+	}
+
+	kevelApiKey := os.Getenv("KEVEL_API_KEY")
+
+	var apiKey string
+	switch {
+	case !data.ApiKey.IsNull():
+		apiKey = data.ApiKey.ValueString()
+	case profileSelected && profile.ApiKey != "":
+		apiKey = profile.ApiKey
+	case kevelApiKey != "":
+		apiKey = kevelApiKey
+		if profile.ApiKey != "" {
+			addEnvironmentOverridesProfileWarning(diags, "KEVEL_API_KEY", "api_key", profileName, credentialsFile)
+		}
+	default:
+		apiKey = profile.ApiKey
+	}
+
+	if apiKey == "" {
+		diags.AddError("Error configuring client", "No API key provided")
+		return "", ""
+	}
+
+	return apiBaseUrl, apiKey
+}
+
+// addEnvironmentOverridesProfileWarning warns that an environment variable
+// took precedence over a value from the default credentials profile, which
+// may not be what was intended.
+func addEnvironmentOverridesProfileWarning(diags *diag.Diagnostics, variable string, key string, profileName string, credentialsFile string) {
+	diags.AddWarning(
+		"Kevel Credentials Overridden by Environment",
+		fmt.Sprintf("The %s environment variable is used instead of the %s of profile %q in credentials file %q. Select the profile with the profile attribute or the KEVEL_PROFILE environment variable to use its %s instead, or unset %s to silence this warning.", variable, key, profileName, credentialsFile, key, variable),
+	)
+}
+
+// verifyCredentials makes a minimal authenticated request so that a rejected
+// API key, or an API base URL that does not lead to Kevel, is reported while
+// configuring the provider rather than part way through an apply.
+func verifyCredentials(ctx context.Context, client *adzerk.ClientWithResponses) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	pageSize := int32(1)
+	response, err := client.ListAdTypesWithResponse(ctx, &adzerk.ListAdTypesParams{PageSize: &pageSize})
+	if err != nil {
+		diags.AddError("Error configuring client", "Could not verify Kevel API credentials, unexpected error: "+err.Error())
+		return diags
+	}
+
+	switch response.StatusCode() {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		detail := "The Kevel API rejected the configured API key"
+		if message := kevelErrorMessage(response.Body); message != "" {
+			detail += ": " + message
+		}
+		diags.AddError("Invalid API key", detail+". Check the api_key attribute, the KEVEL_API_KEY environment variable or the selected credentials profile.")
+	default:
+		diags.Append(checkResponse(response.StatusCode(), response.Body, "Error configuring client", "Could not verify Kevel API credentials", nil)...)
+	}
+
+	return diags
 }
 
 func (p *KevelProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -114,11 +410,20 @@ func (p *KevelProvider) Resources(ctx context.Context) []func() resource.Resourc
 		NewChannelResource,
 		NewChannelSiteMapResource,
 		NewSiteResource,
+		NewZoneResource,
 	}
 }
 
 func (p *KevelProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewAdTypeDataSource,
+		NewAdTypesDataSource,
+		NewChannelDataSource,
+		NewChannelsDataSource,
+		NewChannelSiteMapDataSource,
+		NewSiteDataSource,
+		NewSitesDataSource,
+	}
 }
 
 func New(version string) func() provider.Provider {
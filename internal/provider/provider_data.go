@@ -0,0 +1,19 @@
+package provider
+
+import (
+	adzerk "github.com/cysp/adzerk-management-sdk-go"
+)
+
+// kevelProviderData is shared by every resource and data source configured
+// by a single provider instance.
+type kevelProviderData struct {
+	client  *adzerk.ClientWithResponses
+	adTypes *adTypeIndex
+}
+
+func newKevelProviderData(client *adzerk.ClientWithResponses) *kevelProviderData {
+	return &kevelProviderData{
+		client:  client,
+		adTypes: newAdTypeIndex(client),
+	}
+}
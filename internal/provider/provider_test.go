@@ -1,12 +1,26 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	adzerk "github.com/cysp/adzerk-management-sdk-go"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -42,3 +56,264 @@ resource "kevel_%s" "%s" {
 	%s
 }`, resource, "test", strings.Join(fields, "\n  "))
 }
+
+func testDataSourceConfig(dataSource string, fields ...string) string {
+	return fmt.Sprintf(`
+data "kevel_%s" "%s" {
+	%s
+}`, dataSource, "test", strings.Join(fields, "\n  "))
+}
+
+func testClient(t *testing.T, server string) *adzerk.ClientWithResponses {
+	client, err := adzerk.NewClientWithResponses(server)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+// testCheckResourceAttrInt32Func calls f with the value of an integer
+// attribute of a resource in state, for example to modify the underlying
+// object behind the provider's back.
+func testCheckResourceAttrInt32Func(name string, key string, f func(value int32) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", name)
+		}
+
+		value, err := strconv.ParseInt(rs.Primary.Attributes[key], 10, 32)
+		if err != nil {
+			return fmt.Errorf("could not parse %s.%s: %w", name, key, err)
+		}
+
+		return f(int32(value))
+	}
+}
+
+// testUpgradeResourceState upgrades raw JSON state written by the given schema
+// version of a resource type through the provider server, as Terraform would
+// when reading a state file, and returns the upgraded state.
+func testUpgradeResourceState(t *testing.T, r fwresource.Resource, typeName string, version int64, rawState string) tfsdk.State {
+	t.Helper()
+
+	ctx := context.Background()
+
+	schemaResponse := fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema diagnostics: %v", schemaResponse.Diagnostics)
+	}
+
+	server, err := testAccProtoV6ProviderFactories["kevel"]()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response, err := server.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+		TypeName: typeName,
+		Version:  version,
+		RawState: &tfprotov6.RawState{JSON: []byte(rawState)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, diagnostic := range response.Diagnostics {
+		if diagnostic.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("unexpected upgrade diagnostic: %s: %s", diagnostic.Summary, diagnostic.Detail)
+		}
+	}
+
+	upgraded, err := response.UpgradedState.Unmarshal(schemaResponse.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return tfsdk.State{
+		Schema: schemaResponse.Schema,
+		Raw:    upgraded,
+	}
+}
+
+// testMoveResourceState moves raw JSON state of a resource from another
+// provider into a resource type of this provider through the provider server,
+// as Terraform would for a moved block, and returns the moved state along
+// with any diagnostics.
+func testMoveResourceState(t *testing.T, r fwresource.Resource, sourceProviderAddress string, sourceTypeName string, rawState string, targetTypeName string) (tfsdk.State, []*tfprotov6.Diagnostic) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	schemaResponse := fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema diagnostics: %v", schemaResponse.Diagnostics)
+	}
+
+	server, err := testAccProtoV6ProviderFactories["kevel"]()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response, err := server.MoveResourceState(ctx, &tfprotov6.MoveResourceStateRequest{
+		SourceProviderAddress: sourceProviderAddress,
+		SourceTypeName:        sourceTypeName,
+		SourceState:           &tfprotov6.RawState{JSON: []byte(rawState)},
+		TargetTypeName:        targetTypeName,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state := tfsdk.State{
+		Schema: schemaResponse.Schema,
+	}
+
+	if response.TargetState != nil {
+		state.Raw, err = response.TargetState.Unmarshal(schemaResponse.Schema.Type().TerraformType(ctx))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return state, response.Diagnostics
+}
+
+func TestProviderValidateConfig(t *testing.T) {
+	tests := map[string]struct {
+		config   map[string]tftypes.Value
+		expected diag.Diagnostics
+	}{
+		"empty": {
+			expected: diag.Diagnostics{},
+		},
+		"valid api base url": {
+			config: map[string]tftypes.Value{
+				"api_base_url": tftypes.NewValue(tftypes.String, "https://api.kevel.co/"),
+			},
+			expected: diag.Diagnostics{},
+		},
+		"unknown api base url": {
+			config: map[string]tftypes.Value{
+				"api_base_url": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			},
+			expected: diag.Diagnostics{},
+		},
+		"invalid api base url": {
+			config: map[string]tftypes.Value{
+				"api_base_url": tftypes.NewValue(tftypes.String, "api.kevel.co"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("api_base_url"), "Invalid API Base URL", `"api.kevel.co" must be an absolute URL with an http or https scheme`),
+			},
+		},
+		"api key": {
+			config: map[string]tftypes.Value{
+				"api_key": tftypes.NewValue(tftypes.String, "secret"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("api_key"),
+					"API Key in Configuration",
+					"The Kevel API key is set directly in the provider configuration, where it is likely to be committed to version control. "+
+						"Consider setting it via the KEVEL_API_KEY environment variable or a credentials file profile instead.",
+				),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			p, ok := New("test")().(fwprovider.ProviderWithValidateConfig)
+			if !ok {
+				t.Fatal("expected provider to implement ProviderWithValidateConfig")
+			}
+
+			schemaResponse := fwprovider.SchemaResponse{}
+			p.Schema(ctx, fwprovider.SchemaRequest{}, &schemaResponse)
+
+			configType, ok := schemaResponse.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			if !ok {
+				t.Fatal("expected provider schema to be an object")
+			}
+			configValues := map[string]tftypes.Value{}
+			for name, attributeType := range configType.AttributeTypes {
+				configValues[name] = tftypes.NewValue(attributeType, nil)
+			}
+			for name, value := range test.config {
+				configValues[name] = value
+			}
+
+			response := fwprovider.ValidateConfigResponse{}
+			p.ValidateConfig(ctx, fwprovider.ValidateConfigRequest{
+				Config: tfsdk.Config{
+					Schema: schemaResponse.Schema,
+					Raw:    tftypes.NewValue(configType, configValues),
+				},
+			}, &response)
+
+			if !response.Diagnostics.Equal(test.expected) {
+				t.Errorf("unexpected diagnostics: %v", response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestValidateApiBaseUrl(t *testing.T) {
+	tests := map[string]bool{
+		"https://api.kevel.co/":  true,
+		"http://127.0.0.1:8080":  true,
+		"api.kevel.co":           false,
+		"/v1":                    false,
+		"ftp://api.kevel.co/":    false,
+		"https://":               false,
+		"https://api.kevel.co/%": false,
+	}
+
+	for apiBaseUrl, valid := range tests {
+		t.Run(apiBaseUrl, func(t *testing.T) {
+			err := validateApiBaseUrl(apiBaseUrl)
+			if valid && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !valid && err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
+func TestVerifyCredentials(t *testing.T) {
+	tests := map[string]struct {
+		statusCode      int
+		expectedSummary string
+	}{
+		"ok":           {statusCode: http.StatusOK},
+		"unauthorized": {statusCode: http.StatusUnauthorized, expectedSummary: "Invalid API key"},
+		"forbidden":    {statusCode: http.StatusForbidden, expectedSummary: "Invalid API key"},
+		"not found":    {statusCode: http.StatusNotFound, expectedSummary: "Error configuring client"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(test.statusCode)
+				_, _ = w.Write([]byte(`{"items":[],"page":1,"pageSize":1,"totalItems":0,"totalPages":0}`))
+			}))
+			defer s.Close()
+
+			diags := verifyCredentials(context.Background(), testClient(t, s.URL))
+			if diags.HasError() != (test.expectedSummary != "") {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if test.expectedSummary != "" && diags[0].Summary() != test.expectedSummary {
+				t.Errorf("expected %q diagnostic, got %q", test.expectedSummary, diags[0].Summary())
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// TestResourceMoveState moves raw state as written by equivalent resources of
+// other providers and compares the result with the state this provider would
+// have written.
+func TestResourceMoveState(t *testing.T) {
+	tests := map[string]struct {
+		resource              func() fwresource.Resource
+		sourceProviderAddress string
+		sourceTypeName        string
+		rawState              string
+		targetTypeName        string
+		movedState            string
+		expectError           bool
+	}{
+		"adzerk site": {
+			resource:              NewSiteResource,
+			sourceProviderAddress: "registry.terraform.io/adzerk/adzerk",
+			sourceTypeName:        "adzerk_site",
+			rawState:              `{"id": "123", "title": "one", "url": "https://example.org/one", "publisher_account_id": 5}`,
+			targetTypeName:        "kevel_site",
+			movedState:            `{"id": 123, "title": "one", "url": "https://example.org/one", "timeouts": null}`,
+		},
+		"kevel site from another provider": {
+			resource:              NewSiteResource,
+			sourceProviderAddress: "registry.terraform.io/example/kevel",
+			sourceTypeName:        "kevel_site",
+			rawState:              `{"id": 123, "title": "one", "url": "https://example.org/one"}`,
+			targetTypeName:        "kevel_site",
+			movedState:            `{"id": 123, "title": "one", "url": "https://example.org/one", "timeouts": null}`,
+		},
+		"adzerk site without id": {
+			resource:              NewSiteResource,
+			sourceProviderAddress: "registry.terraform.io/adzerk/adzerk",
+			sourceTypeName:        "adzerk_site",
+			rawState:              `{"title": "one", "url": "https://example.org/one"}`,
+			targetTypeName:        "kevel_site",
+			expectError:           true,
+		},
+		"adzerk site with invalid id": {
+			resource:              NewSiteResource,
+			sourceProviderAddress: "registry.terraform.io/adzerk/adzerk",
+			sourceTypeName:        "adzerk_site",
+			rawState:              `{"id": "one", "title": "one", "url": "https://example.org/one"}`,
+			targetTypeName:        "kevel_site",
+			expectError:           true,
+		},
+		"unsupported source": {
+			resource:              NewSiteResource,
+			sourceProviderAddress: "registry.terraform.io/adzerk/adzerk",
+			sourceTypeName:        "adzerk_zone",
+			rawState:              `{"id": "123", "name": "one", "site_id": "5"}`,
+			targetTypeName:        "kevel_site",
+			expectError:           true,
+		},
+		"adzerk channel": {
+			resource:              NewChannelResource,
+			sourceProviderAddress: "registry.terraform.io/adzerk/adzerk",
+			sourceTypeName:        "adzerk_channel",
+			rawState:              `{"id": "123", "title": "one", "ad_types": ["5", 234, 5], "engine": "CPM"}`,
+			targetTypeName:        "kevel_channel",
+			movedState:            `{"id": 123, "title": "one", "ad_types": [5, 234], "timeouts": null}`,
+		},
+		"adzerk channel site map": {
+			resource:              NewChannelSiteMapResource,
+			sourceProviderAddress: "registry.terraform.io/adzerk/adzerk",
+			sourceTypeName:        "adzerk_channel_site_map",
+			rawState:              `{"id": "1-2", "channel_id": "1", "site_id": 2, "priority": 10}`,
+			targetTypeName:        "kevel_channel_site_map",
+			movedState:            `{"id": "1:2", "channel_id": 1, "site_id": 2, "priority": 10, "timeouts": null}`,
+		},
+		"adzerk channel site map without site": {
+			resource:              NewChannelSiteMapResource,
+			sourceProviderAddress: "registry.terraform.io/adzerk/adzerk",
+			sourceTypeName:        "adzerk_channel_site_map",
+			rawState:              `{"id": "1-2", "channel_id": "1", "priority": 10}`,
+			targetTypeName:        "kevel_channel_site_map",
+			expectError:           true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			state, diagnostics := testMoveResourceState(t, test.resource(), test.sourceProviderAddress, test.sourceTypeName, test.rawState, test.targetTypeName)
+
+			hasError := false
+			for _, diagnostic := range diagnostics {
+				if diagnostic.Severity == tfprotov6.DiagnosticSeverityError {
+					hasError = true
+					if !test.expectError {
+						t.Errorf("unexpected move diagnostic: %s: %s", diagnostic.Summary, diagnostic.Detail)
+					}
+				}
+			}
+
+			if test.expectError {
+				if !hasError {
+					t.Errorf("expected an error diagnostic")
+				}
+				return
+			}
+
+			expected, err := (&tfprotov6.RawState{JSON: []byte(test.movedState)}).UnmarshalWithOpts(
+				state.Schema.Type().TerraformType(context.Background()),
+				tfprotov6.UnmarshalOpts{},
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !state.Raw.Equal(expected) {
+				diffs, _ := state.Raw.Diff(expected)
+				t.Errorf("unexpected moved state: %v", diffs)
+			}
+		})
+	}
+}
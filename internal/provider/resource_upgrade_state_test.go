@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// TestResourceUpgradeState loads raw state as written by each prior schema
+// version of a resource and compares the upgraded state with the state the
+// current schema version would have written.
+func TestResourceUpgradeState(t *testing.T) {
+	tests := map[string]struct {
+		resource     func() fwresource.Resource
+		typeName     string
+		version      int64
+		rawState     string
+		upgradeState string
+	}{
+		"site v0": {
+			resource:     NewSiteResource,
+			typeName:     "kevel_site",
+			version:      0,
+			rawState:     `{"id": 123, "title": "one", "url": "https://example.org/one"}`,
+			upgradeState: `{"id": 123, "title": "one", "url": "https://example.org/one", "timeouts": null}`,
+		},
+		"site v1": {
+			resource:     NewSiteResource,
+			typeName:     "kevel_site",
+			version:      1,
+			rawState:     `{"id": 123, "title": "one", "url": "https://example.org/one", "timeouts": {"create": "1m", "read": null, "update": null, "delete": null}}`,
+			upgradeState: `{"id": 123, "title": "one", "url": "https://example.org/one", "timeouts": {"create": "1m", "read": null, "update": null, "delete": null}}`,
+		},
+		"ad type v0": {
+			resource:     NewAdTypeResource,
+			typeName:     "kevel_ad_type",
+			version:      0,
+			rawState:     `{"id": 123, "name": "banner", "width": 300, "height": 250}`,
+			upgradeState: `{"id": 123, "name": "banner", "width": 300, "height": 250, "timeouts": null}`,
+		},
+		"ad type v0 without name": {
+			resource:     NewAdTypeResource,
+			typeName:     "kevel_ad_type",
+			version:      0,
+			rawState:     `{"id": 123, "name": null, "width": 300, "height": 250}`,
+			upgradeState: `{"id": 123, "name": null, "width": 300, "height": 250, "timeouts": null}`,
+		},
+		"channel v0": {
+			resource:     NewChannelResource,
+			typeName:     "kevel_channel",
+			version:      0,
+			rawState:     `{"id": 123, "title": "one", "ad_types": [234, 5]}`,
+			upgradeState: `{"id": 123, "title": "one", "ad_types": [234, 5], "timeouts": null}`,
+		},
+		"channel v0 with duplicate ad types": {
+			resource:     NewChannelResource,
+			typeName:     "kevel_channel",
+			version:      0,
+			rawState:     `{"id": 123, "title": "one", "ad_types": [234, 5, 234]}`,
+			upgradeState: `{"id": 123, "title": "one", "ad_types": [234, 5], "timeouts": null}`,
+		},
+		"channel v0 with timeouts": {
+			resource:     NewChannelResource,
+			typeName:     "kevel_channel",
+			version:      0,
+			rawState:     `{"id": 123, "title": "one", "ad_types": [], "timeouts": {"create": null, "read": "30s", "update": null, "delete": null}}`,
+			upgradeState: `{"id": 123, "title": "one", "ad_types": [], "timeouts": {"create": null, "read": "30s", "update": null, "delete": null}}`,
+		},
+		"channel site map v0": {
+			resource:     NewChannelSiteMapResource,
+			typeName:     "kevel_channel_site_map",
+			version:      0,
+			rawState:     `{"id": "1:2", "channel_id": 1, "site_id": 2, "priority": 10}`,
+			upgradeState: `{"id": "1:2", "channel_id": 1, "site_id": 2, "priority": 10, "timeouts": null}`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			state := testUpgradeResourceState(t, test.resource(), test.typeName, test.version, test.rawState)
+
+			expected, err := (&tfprotov6.RawState{JSON: []byte(test.upgradeState)}).UnmarshalWithOpts(
+				state.Schema.Type().TerraformType(context.Background()),
+				tfprotov6.UnmarshalOpts{},
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !state.Raw.Equal(expected) {
+				diffs, _ := state.Raw.Diff(expected)
+				t.Errorf("unexpected upgraded state: %v", diffs)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// checkResponse returns an error diagnostic when a Kevel API response has a
+// non-2xx status code. The detail includes the status code and the error
+// message from the response body, and when that message names one of the
+// fields the diagnostic is attached to the matching attribute.
+func checkResponse(statusCode int, body []byte, summary string, detail string, fields []responseField) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	if statusCode >= 200 && statusCode < 300 {
+		return diags
+	}
+
+	detail = detail + ", unexpected status code: " + strconv.Itoa(statusCode)
+
+	message := kevelErrorMessage(body)
+	if message != "" {
+		detail = detail + ": " + message
+	}
+
+	if attributePath, found := fieldPathForMessage(message, fields); found {
+		diags.AddAttributeError(attributePath, summary, detail)
+	} else {
+		diags.AddError(summary, detail)
+	}
+
+	return diags
+}
+
+// responseError returns an error describing a Kevel API response with a
+// non-2xx status code, for callers that do not report diagnostics directly.
+func responseError(statusCode int, body []byte) error {
+	if statusCode >= 200 && statusCode < 300 {
+		return nil
+	}
+
+	message := "unexpected status code: " + strconv.Itoa(statusCode)
+	if kevelMessage := kevelErrorMessage(body); kevelMessage != "" {
+		message = message + ": " + kevelMessage
+	}
+
+	return errors.New(message)
+}
+
+type kevelErrorBody struct {
+	Message string   `json:"message"`
+	Error   string   `json:"error"`
+	Errors  []string `json:"errors"`
+}
+
+func kevelErrorMessage(body []byte) string {
+	var errorBody kevelErrorBody
+	if err := json.Unmarshal(body, &errorBody); err == nil {
+		messages := []string{}
+		for _, message := range append([]string{errorBody.Message, errorBody.Error}, errorBody.Errors...) {
+			if message != "" {
+				messages = append(messages, message)
+			}
+		}
+		if len(messages) > 0 {
+			return strings.Join(messages, "; ")
+		}
+	}
+
+	var errorString string
+	if err := json.Unmarshal(body, &errorString); err == nil {
+		return strings.TrimSpace(errorString)
+	}
+
+	return strings.TrimSpace(string(body))
+}
+
+// responseField pairs a field name that may appear in Kevel error messages
+// with the attribute that diagnostics for it are attached to.
+type responseField struct {
+	pattern       *regexp.Regexp
+	attributePath path.Path
+}
+
+func newResponseField(field string, attributePath path.Path) responseField {
+	return responseField{
+		pattern:       regexp.MustCompile(`(?i)\b` + regexp.QuoteMeta(field) + `\b`),
+		attributePath: attributePath,
+	}
+}
+
+// fieldPathForMessage returns the attribute of the first field named in the
+// message, checking fields in order so that a message naming several fields
+// is always reported against the same attribute.
+func fieldPathForMessage(message string, fields []responseField) (path.Path, bool) {
+	if message == "" {
+		return path.Empty(), false
+	}
+
+	for _, field := range fields {
+		if field.pattern.MatchString(message) {
+			return field.attributePath, true
+		}
+	}
+
+	return path.Empty(), false
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestCheckResponse(t *testing.T) {
+	fields := []responseField{
+		newResponseField("Title", path.Root("title")),
+		newResponseField("Url", path.Root("url")),
+	}
+
+	testCases := map[string]struct {
+		statusCode int
+		body       string
+		expected   diag.Diagnostics
+	}{
+		"success": {
+			statusCode: 200,
+			body:       `{"Id":1}`,
+			expected:   diag.Diagnostics{},
+		},
+		"json message with field": {
+			statusCode: 400,
+			body:       `{"message":"Title is required"}`,
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("title"), "Error", "Could not do thing, unexpected status code: 400: Title is required"),
+			},
+		},
+		"json message with several fields": {
+			statusCode: 400,
+			body:       `{"message":"Url and Title are required"}`,
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("title"), "Error", "Could not do thing, unexpected status code: 400: Url and Title are required"),
+			},
+		},
+		"json string": {
+			statusCode: 400,
+			body:       `"This site does not exist"`,
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error", "Could not do thing, unexpected status code: 400: This site does not exist"),
+			},
+		},
+		"plain text": {
+			statusCode: 500,
+			body:       "Internal Server Error\n",
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error", "Could not do thing, unexpected status code: 500: Internal Server Error"),
+			},
+		},
+		"empty body": {
+			statusCode: 403,
+			body:       "",
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error", "Could not do thing, unexpected status code: 403"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diags := checkResponse(testCase.statusCode, []byte(testCase.body), "Error", "Could not do thing", fields)
+			if !diags.Equal(testCase.expected) {
+				t.Errorf("unexpected diagnostics: %v", diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	adzerk "github.com/cysp/adzerk-management-sdk-go"
+)
+
+var (
+	_ datasource.DataSource                     = &siteDataSource{}
+	_ datasource.DataSourceWithConfigure        = &siteDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &siteDataSource{}
+)
+
+func NewSiteDataSource() datasource.DataSource {
+	return &siteDataSource{}
+}
+
+type siteDataSource struct {
+	client *adzerk.ClientWithResponses
+}
+
+type siteDataSourceModel struct {
+	Id    types.Int64  `tfsdk:"id"`
+	Title types.String `tfsdk:"title"`
+	Url   types.String `tfsdk:"url"`
+}
+
+func (d *siteDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site"
+}
+
+func (d *siteDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Kevel Site",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "Numeric identifier of the site",
+				Optional:    true,
+				Computed:    true,
+			},
+			"title": schema.StringAttribute{
+				Description: "Title of the site. Must match exactly one site that is not deleted",
+				Optional:    true,
+				Computed:    true,
+			},
+			"url": schema.StringAttribute{
+				Description: "URL of the site",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *siteDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("title"),
+		),
+	}
+}
+
+func (d *siteDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*kevelProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Error", "Could not get client from provider data")
+		return
+	}
+
+	d.client = providerData.client
+}
+
+func (d *siteDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config siteDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Id.IsNull() {
+		response, err := d.client.GetSiteWithResponse(ctx, int32(config.Id.ValueInt64()))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Kevel Site",
+				"Could not read site ID "+config.Id.String()+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(checkResponse(response.StatusCode(), response.Body, "Error Reading Kevel Site", "Could not read site ID "+config.Id.String(), nil)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Kevel answers a request for an unknown ID with a null body, which
+		// decodes to an empty site.
+		if response.JSON200 == nil || response.JSON200.Id == 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Error Reading Kevel Site",
+				"No site found with ID "+config.Id.String(),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(setStateWithSite(&resp.State, ctx, response.JSON200)...)
+		return
+	}
+
+	sites, err := listAllSites(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Kevel Site",
+			"Could not list sites, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	site, count := findUnique(sites, func(site adzerk.Site) bool {
+		return site.Title == config.Title.ValueString() && (site.IsDeleted == nil || !*site.IsDeleted)
+	})
+
+	if count != 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("title"),
+			"Error Reading Kevel Site",
+			"Expected exactly one site with title "+config.Title.String()+", found "+strconv.Itoa(count),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setStateWithSite(&resp.State, ctx, site)...)
+}
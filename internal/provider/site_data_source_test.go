@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	adzerk "github.com/cysp/adzerk-management-sdk-go"
+	"github.com/cysp/adzerk-management-sdk-go/testserver"
+)
+
+func TestSiteDataSource(t *testing.T) {
+	s := testserver.NewHttpTestServer()
+	defer s.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testSiteResourceConfig("one", "https://example.org/one"),
+					testDataSourceConfig("site", `id = kevel_site.test.id`),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.kevel_site.test", "id", "kevel_site.test", "id"),
+					resource.TestCheckResourceAttr("data.kevel_site.test", "title", "one"),
+					resource.TestCheckResourceAttr("data.kevel_site.test", "url", "https://example.org/one"),
+				),
+			},
+		},
+	})
+}
+
+func TestSiteDataSourceLookup(t *testing.T) {
+	isDeleted := true
+
+	s := newTestServerWithLists([]adzerk.Site{
+		{Id: 1, Title: "Example", Url: "https://example.org/"},
+		{Id: 2, Title: "Duplicate", Url: "https://one.example.org/"},
+		{Id: 3, Title: "Duplicate", Url: "https://two.example.org/"},
+		{Id: 4, Title: "Archive", Url: "https://archive.example.org/", IsDeleted: &isDeleted},
+	}, nil)
+	defer s.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testDataSourceConfig("site", `title = "Example"`),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kevel_site.test", "id", "1"),
+					resource.TestCheckResourceAttr("data.kevel_site.test", "url", "https://example.org/"),
+				),
+			},
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testDataSourceConfig("site", `title = "Missing"`),
+				),
+				ExpectError: regexp.MustCompile(`Expected\s+exactly\s+one\s+site\s+with\s+title\s+"Missing",\s+found\s+0`),
+			},
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testDataSourceConfig("site", `title = "Duplicate"`),
+				),
+				ExpectError: regexp.MustCompile(`Expected\s+exactly\s+one\s+site\s+with\s+title\s+"Duplicate",\s+found\s+2`),
+			},
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testDataSourceConfig("site", `title = "Archive"`),
+				),
+				ExpectError: regexp.MustCompile(`Expected\s+exactly\s+one\s+site\s+with\s+title\s+"Archive",\s+found\s+0`),
+			},
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testDataSourceConfig("site", `id = 999`),
+				),
+				ExpectError: regexp.MustCompile(`No\s+site\s+found\s+with\s+ID\s+999`),
+			},
+		},
+	})
+}
@@ -3,7 +3,9 @@ package provider
 import (
 	"context"
 	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -14,9 +16,11 @@ import (
 )
 
 var (
-	_ resource.Resource                = &siteResource{}
-	_ resource.ResourceWithConfigure   = &siteResource{}
-	_ resource.ResourceWithImportState = &siteResource{}
+	_ resource.Resource                 = &siteResource{}
+	_ resource.ResourceWithConfigure    = &siteResource{}
+	_ resource.ResourceWithImportState  = &siteResource{}
+	_ resource.ResourceWithUpgradeState = &siteResource{}
+	_ resource.ResourceWithMoveState    = &siteResource{}
 )
 
 func NewSiteResource() resource.Resource {
@@ -31,9 +35,10 @@ func (r *siteResource) Metadata(_ context.Context, req resource.MetadataRequest,
 	resp.TypeName = req.ProviderTypeName + "_site"
 }
 
-func (r *siteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *siteResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Kevel Site",
+		Version:     1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				Description: "Numeric identifier of the site",
@@ -51,6 +56,14 @@ func (r *siteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Required:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -59,13 +72,13 @@ func (r *siteResource) Configure(_ context.Context, req resource.ConfigureReques
 		return
 	}
 
-	client, ok := req.ProviderData.(*adzerk.ClientWithResponses)
+	providerData, ok := req.ProviderData.(*kevelProviderData)
 	if !ok {
 		resp.Diagnostics.AddError("Error", "Could not get client from provider data")
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
 }
 
 func (r *siteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -76,6 +89,15 @@ func (r *siteResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	response, err := r.client.CreateSiteWithResponse(ctx, plan.createRequestBody())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -85,7 +107,13 @@ func (r *siteResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	resp.Diagnostics.Append(checkResponse(response.StatusCode(), response.Body, "Error creating site", "Could not create site", siteResponseFields)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(setStateWithSite(&resp.State, ctx, response.JSON200)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeouts"), plan.Timeouts)...)
 }
 
 func (r *siteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -96,6 +124,15 @@ func (r *siteResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	response, err := r.client.GetSiteWithResponse(ctx, int32(state.Id.ValueInt64()))
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -105,6 +142,21 @@ func (r *siteResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	if isNotFoundResponse(response.StatusCode(), response.Body) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(checkResponse(response.StatusCode(), response.Body, "Error Reading Kevel Site", "Could not read site ID "+state.Id.String(), siteResponseFields)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if site := response.JSON200; site != nil && site.IsDeleted != nil && *site.IsDeleted {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	resp.Diagnostics.Append(setStateWithSite(&resp.State, ctx, response.JSON200)...)
 }
 
@@ -116,6 +168,15 @@ func (r *siteResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	response, err := r.client.UpdateSiteWithResponse(ctx, int32(plan.Id.ValueInt64()), plan.updateRequestBody())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -125,7 +186,13 @@ func (r *siteResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	resp.Diagnostics.Append(checkResponse(response.StatusCode(), response.Body, "Error updating Kevel Site", "Could not update site ID "+plan.Id.String(), siteResponseFields)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(setStateWithSite(&resp.State, ctx, response.JSON200)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeouts"), plan.Timeouts)...)
 }
 
 func (r *siteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -136,6 +203,15 @@ func (r *siteResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	response, err := r.client.UpdateSiteWithResponse(ctx, int32(state.Id.ValueInt64()), state.deleteRequestBody())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -145,15 +221,34 @@ func (r *siteResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	if response.StatusCode() != 200 {
-		resp.Diagnostics.AddError(
-			"Error Deleting Kevel Site",
-			"Could not delete site ID "+state.Id.String()+", unexpected status code: "+strconv.Itoa(response.StatusCode()),
-		)
+	resp.Diagnostics.Append(checkResponse(response.StatusCode(), response.Body, "Error Deleting Kevel Site", "Could not delete site ID "+state.Id.String(), siteResponseFields)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
+// ImportState accepts either a numeric ID or "title:<title>", which must
+// match exactly one site that is not deleted.
 func (r *siteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	ImportStatePassthroughInt64ID(ctx, path.Root("id"), req, resp)
+	title, found := strings.CutPrefix(req.ID, "title:")
+	if !found {
+		ImportStatePassthroughInt64ID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	sites, err := listAllSites(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing Kevel Site",
+			"Could not list sites, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	importStateMatching(ctx, sites,
+		func(site adzerk.Site) bool {
+			return site.Title == title && (site.IsDeleted == nil || !*site.IsDeleted)
+		},
+		func(site adzerk.Site) int32 { return site.Id },
+		"site", "title "+strconv.Quote(title), "Error importing Kevel Site", req, resp)
 }
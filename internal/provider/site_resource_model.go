@@ -1,15 +1,23 @@
 package provider
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	adzerk "github.com/cysp/adzerk-management-sdk-go"
 )
 
+var siteResponseFields = []responseField{
+	newResponseField("Title", path.Root("title")),
+	newResponseField("Url", path.Root("url")),
+}
+
 type siteResourceModel struct {
-	Id    types.Int64  `tfsdk:"id"`
-	Title types.String `tfsdk:"title"`
-	Url   types.String `tfsdk:"url"`
+	Id       types.Int64    `tfsdk:"id"`
+	Title    types.String   `tfsdk:"title"`
+	Url      types.String   `tfsdk:"url"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (m *siteResourceModel) createRequestBody() adzerk.CreateSiteJSONRequestBody {
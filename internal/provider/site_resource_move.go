@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func (r *siteResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: moveSiteResourceState,
+		},
+	}
+}
+
+// moveSiteResourceState moves an adzerk_site, or a kevel_site from another
+// provider, into a kevel_site.
+func moveSiteResourceState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if !isMoveStateSource(req, "site") {
+		return
+	}
+
+	source := newMovedState(req)
+	id := source.int64("id", true)
+	title := source.string("title")
+	url := source.string("url")
+
+	resp.Diagnostics.Append(source.diagnostics()...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("title"), title)...)
+	resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("url"), url)...)
+}
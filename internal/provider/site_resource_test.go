@@ -2,15 +2,20 @@ package provider
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 
 	"github.com/cysp/adzerk-management-sdk-go/testserver"
 )
 
 func TestSiteResource(t *testing.T) {
-	s := testserver.NewHttpTestServer()
+	s := newTestServerWithLists(nil, nil)
 	defer s.Close()
 
 	resource.UnitTest(t, resource.TestCase{
@@ -33,6 +38,12 @@ func TestSiteResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      "kevel_site.test",
+				ImportState:       true,
+				ImportStateId:     "title:one",
+				ImportStateVerify: true,
+			},
 			// Update and Read testing
 			{
 				Config: testCombinedConfig(
@@ -50,3 +61,162 @@ func testSiteResourceConfig(title string, url string) string {
 	urlField := fmt.Sprintf(`url = %q`, url)
 	return testResourceConfig("site", titleField, urlField)
 }
+
+func TestSiteResourceDisappears(t *testing.T) {
+	s := testserver.NewHttpTestServer()
+	defer s.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testSiteResourceConfig("one", "https://example.org/one"),
+				),
+				Check: testCheckResourceAttrInt32Func("kevel_site.test", "id", func(id int32) error {
+					response, err := http.Get(fmt.Sprintf("%s/v1/site/%d/delete", s.URL, id))
+					if err != nil {
+						return err
+					}
+					return response.Body.Close()
+				}),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("kevel_site.test", plancheck.ResourceActionCreate),
+					},
+				},
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestSiteResourceCreateError(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message":"Url must be a valid URL"}`))
+	}))
+	defer s.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testSiteResourceConfig("one", "not a url"),
+				),
+				ExpectError: regexp.MustCompile(`unexpected\s+status\s+code:\s+400:\s+Url\s+must\s+be\s+a\s+valid\s+URL`),
+			},
+		},
+	})
+}
+
+func TestSiteResourceTimeouts(t *testing.T) {
+	s := testserver.NewHttpTestServer()
+	defer s.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testResourceConfig("site",
+						`title = "one"`,
+						`url = "https://example.org/one"`,
+						`timeouts {
+							create = "1m"
+							delete = "30s"
+						}`,
+					),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("kevel_site.test", "id"),
+					resource.TestCheckResourceAttr("kevel_site.test", "timeouts.create", "1m"),
+					resource.TestCheckResourceAttr("kevel_site.test", "timeouts.delete", "30s"),
+				),
+			},
+		},
+	})
+}
+
+func TestSiteResourceCreateTimeout(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			_, _ = w.Write([]byte(`{}`))
+			return
+		}
+
+		select {
+		case <-r.Context().Done():
+		case <-time.After(30 * time.Second):
+		}
+	}))
+	defer s.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testResourceConfig("site",
+						`title = "one"`,
+						`url = "https://example.org/one"`,
+						`timeouts {
+							create = "1s"
+						}`,
+					),
+				),
+				ExpectError: regexp.MustCompile(`context\s+deadline\s+exceeded`),
+			},
+		},
+	})
+}
+
+func TestSiteResourceImportAmbiguousTitle(t *testing.T) {
+	s := newTestServerWithLists(nil, nil)
+	defer s.Close()
+
+	config := testCombinedConfig(
+		testProviderConfig(s.URL),
+		testSiteResourceConfig("duplicate", "https://example.org/one"),
+		`
+resource "kevel_site" "other" {
+	title = "duplicate"
+	url = "https://example.org/two"
+}
+`,
+	)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				Config:        config,
+				ResourceName:  "kevel_site.test",
+				ImportState:   true,
+				ImportStateId: "title:duplicate",
+				ExpectError:   regexp.MustCompile(`title\s+"duplicate"\s+matched\s+more\s+than\s+one\s+site`),
+			},
+			{
+				Config:        config,
+				ResourceName:  "kevel_site.test",
+				ImportState:   true,
+				ImportStateId: "title:missing",
+				ExpectError:   regexp.MustCompile(`no\s+site\s+found\s+with\s+title\s+"missing"`),
+			},
+		},
+	})
+}
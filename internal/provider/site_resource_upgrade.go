@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// siteResourceModelV0 is the state of a site before the timeouts block was
+// added.
+type siteResourceModelV0 struct {
+	Id    types.Int64  `tfsdk:"id"`
+	Title types.String `tfsdk:"title"`
+	Url   types.String `tfsdk:"url"`
+}
+
+func siteResourceSchemaV0() *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+			},
+			"title": schema.StringAttribute{
+				Required: true,
+			},
+			"url": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *siteResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   siteResourceSchemaV0(),
+			StateUpgrader: upgradeSiteResourceStateV0,
+		},
+	}
+}
+
+func upgradeSiteResourceStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior siteResourceModelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeoutsValue, diags := nullTimeouts(ctx, resp.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, siteResourceModel{
+		Id:       prior.Id,
+		Title:    prior.Title,
+		Url:      prior.Url,
+		Timeouts: timeoutsValue,
+	})...)
+}
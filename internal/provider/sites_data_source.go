@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	adzerk "github.com/cysp/adzerk-management-sdk-go"
+)
+
+var (
+	_ datasource.DataSource              = &sitesDataSource{}
+	_ datasource.DataSourceWithConfigure = &sitesDataSource{}
+)
+
+func NewSitesDataSource() datasource.DataSource {
+	return &sitesDataSource{}
+}
+
+type sitesDataSource struct {
+	client *adzerk.ClientWithResponses
+}
+
+type sitesDataSourceModel struct {
+	TitleRegex types.String               `tfsdk:"title_regex"`
+	UrlRegex   types.String               `tfsdk:"url_regex"`
+	IsDeleted  types.Bool                 `tfsdk:"is_deleted"`
+	Sites      []sitesDataSourceItemModel `tfsdk:"sites"`
+}
+
+type sitesDataSourceItemModel struct {
+	Id        types.Int64  `tfsdk:"id"`
+	Title     types.String `tfsdk:"title"`
+	Url       types.String `tfsdk:"url"`
+	IsDeleted types.Bool   `tfsdk:"is_deleted"`
+}
+
+func (d *sitesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sites"
+}
+
+func (d *sitesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Kevel Sites",
+		Attributes: map[string]schema.Attribute{
+			"title_regex": schema.StringAttribute{
+				Description: "Regular expression that site titles must match",
+				Optional:    true,
+			},
+			"url_regex": schema.StringAttribute{
+				Description: "Regular expression that site URLs must match",
+				Optional:    true,
+			},
+			"is_deleted": schema.BoolAttribute{
+				Description: "Deletion state that sites must match. Defaults to false",
+				Optional:    true,
+			},
+			"sites": schema.ListNestedAttribute{
+				Description: "List of matching sites",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "Numeric identifier of the site",
+							Computed:    true,
+						},
+						"title": schema.StringAttribute{
+							Description: "Title of the site",
+							Computed:    true,
+						},
+						"url": schema.StringAttribute{
+							Description: "URL of the site",
+							Computed:    true,
+						},
+						"is_deleted": schema.BoolAttribute{
+							Description: "Whether the site is deleted",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *sitesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*kevelProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Error", "Could not get client from provider data")
+		return
+	}
+
+	d.client = providerData.client
+}
+
+func (d *sitesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data sitesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	titleRegexp := compileRegexpAttribute(path.Root("title_regex"), data.TitleRegex, &resp.Diagnostics)
+	urlRegexp := compileRegexpAttribute(path.Root("url_regex"), data.UrlRegex, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sites, err := listAllSites(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Kevel Sites",
+			"Could not list sites, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	isDeleted := data.IsDeleted.ValueBool()
+
+	data.Sites = []sitesDataSourceItemModel{}
+	for _, site := range sites {
+		siteIsDeleted := site.IsDeleted != nil && *site.IsDeleted
+		if siteIsDeleted != isDeleted {
+			continue
+		}
+		if titleRegexp != nil && !titleRegexp.MatchString(site.Title) {
+			continue
+		}
+		if urlRegexp != nil && !urlRegexp.MatchString(site.Url) {
+			continue
+		}
+
+		data.Sites = append(data.Sites, sitesDataSourceItemModel{
+			Id:        types.Int64Value(int64(site.Id)),
+			Title:     types.StringValue(site.Title),
+			Url:       types.StringValue(site.Url),
+			IsDeleted: types.BoolValue(siteIsDeleted),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
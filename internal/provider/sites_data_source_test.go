@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	adzerk "github.com/cysp/adzerk-management-sdk-go"
+)
+
+func TestSitesDataSource(t *testing.T) {
+	isDeleted := true
+
+	s := newTestServerWithLists([]adzerk.Site{
+		{Id: 1, Title: "Example", Url: "https://example.org/"},
+		{Id: 2, Title: "Example Staging", Url: "https://staging.example.org/"},
+		{Id: 3, Title: "Example Archive", Url: "https://archive.example.org/", IsDeleted: &isDeleted},
+	}, nil)
+	defer s.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testDataSourceConfig("sites"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kevel_sites.test", "sites.#", "2"),
+					resource.TestCheckResourceAttr("data.kevel_sites.test", "sites.0.id", "1"),
+					resource.TestCheckResourceAttr("data.kevel_sites.test", "sites.1.id", "2"),
+				),
+			},
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testDataSourceConfig("sites",
+						`title_regex = "^Example"`,
+						`url_regex = "^https://staging\\."`,
+					),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kevel_sites.test", "sites.#", "1"),
+					resource.TestCheckResourceAttr("data.kevel_sites.test", "sites.0.id", "2"),
+					resource.TestCheckResourceAttr("data.kevel_sites.test", "sites.0.title", "Example Staging"),
+					resource.TestCheckResourceAttr("data.kevel_sites.test", "sites.0.url", "https://staging.example.org/"),
+				),
+			},
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testDataSourceConfig("sites",
+						`is_deleted = true`,
+					),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kevel_sites.test", "sites.#", "1"),
+					resource.TestCheckResourceAttr("data.kevel_sites.test", "sites.0.id", "3"),
+					resource.TestCheckResourceAttr("data.kevel_sites.test", "sites.0.is_deleted", "true"),
+				),
+			},
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testDataSourceConfig("sites",
+						`title_regex = "^nomatch$"`,
+					),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kevel_sites.test", "sites.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+
+	adzerk "github.com/cysp/adzerk-management-sdk-go"
+	"github.com/cysp/adzerk-management-sdk-go/testserver"
+)
+
+// newTestServerWithLists returns the SDK test server extended with site and
+// channel list routes, which the SDK test server does not provide. The lists
+// serve the given objects followed by any created through the server.
+func newTestServerWithLists(sites []adzerk.Site, channels []adzerk.Channel) *httptest.Server {
+	base := testserver.NewHttpTestServer()
+	base.Close()
+
+	var mu sync.Mutex
+	createdSiteIds := []int32{}
+	createdChannelIds := []int32{}
+
+	mux := http.NewServeMux()
+	mux.Handle("/", base.Config.Handler)
+
+	mux.HandleFunc("POST /v1/site", func(w http.ResponseWriter, r *http.Request) {
+		site := adzerk.Site{}
+		if testServeAndDecode(base.Config.Handler, w, r, &site) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			createdSiteIds = append(createdSiteIds, site.Id)
+		}
+	})
+
+	mux.HandleFunc("GET /v1/site", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		items := append([]adzerk.Site{}, sites...)
+		for _, id := range createdSiteIds {
+			site := (*adzerk.Site)(nil)
+			if testGetAndDecode(base.Config.Handler, "/v1/site/"+strconv.Itoa(int(id)), &site) && site != nil {
+				items = append(items, *site)
+			}
+		}
+
+		testWriteJson(w, adzerk.SiteList{
+			Items:      items,
+			Page:       1,
+			PageSize:   int32(len(items)),
+			TotalItems: int64(len(items)),
+			TotalPages: 1,
+		})
+	})
+
+	mux.HandleFunc("POST /v1/channel", func(w http.ResponseWriter, r *http.Request) {
+		channel := adzerk.Channel{}
+		if testServeAndDecode(base.Config.Handler, w, r, &channel) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			createdChannelIds = append(createdChannelIds, channel.Id)
+		}
+	})
+
+	mux.HandleFunc("GET /v1/channel", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		channelList := adzerk.ChannelList{ChannelIds: []int32{}}
+		if page := r.URL.Query().Get("page"); page == "" || page == "1" {
+			for _, channel := range channels {
+				channelList.ChannelIds = append(channelList.ChannelIds, channel.Id)
+			}
+
+			for _, id := range createdChannelIds {
+				channel := (*adzerk.Channel)(nil)
+				if testGetAndDecode(base.Config.Handler, "/v1/channel/"+strconv.Itoa(int(id)), &channel) && channel != nil {
+					channelList.ChannelIds = append(channelList.ChannelIds, id)
+				}
+			}
+		}
+
+		testWriteJson(w, channelList)
+	})
+
+	mux.HandleFunc("GET /v1/channel/{id}", func(w http.ResponseWriter, r *http.Request) {
+		for _, channel := range channels {
+			if strconv.Itoa(int(channel.Id)) == r.PathValue("id") {
+				testWriteJson(w, channel)
+				return
+			}
+		}
+
+		base.Config.Handler.ServeHTTP(w, r)
+	})
+
+	return httptest.NewServer(mux)
+}
+
+// testServeAndDecode passes the request on to the handler, copying its
+// response to w, and decodes a successful response body into v.
+func testServeAndDecode(handler http.Handler, w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, r)
+
+	for key, values := range recorder.Header() {
+		w.Header()[key] = values
+	}
+	w.WriteHeader(recorder.Code)
+	_, _ = w.Write(recorder.Body.Bytes())
+
+	return recorder.Code == http.StatusOK && json.Unmarshal(recorder.Body.Bytes(), v) == nil
+}
+
+// testGetAndDecode makes a GET request for the path to the handler and
+// decodes a successful response body into v.
+func testGetAndDecode(handler http.Handler, path string, v interface{}) bool {
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+
+	return recorder.Code == http.StatusOK && json.Unmarshal(recorder.Body.Bytes(), v) == nil
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+
+	adzerk "github.com/cysp/adzerk-management-sdk-go"
+	"github.com/cysp/adzerk-management-sdk-go/testserver"
+)
+
+// newTestServerWithZones returns the SDK test server extended with in-memory
+// zone routes, which the SDK test server does not provide.
+func newTestServerWithZones() *httptest.Server {
+	base := testserver.NewHttpTestServer()
+	base.Close()
+
+	var mu sync.Mutex
+	zones := make(map[int32]*adzerk.Zone)
+	zoneIdCounter := int32(300_000)
+
+	mux := http.NewServeMux()
+	mux.Handle("/", base.Config.Handler)
+
+	mux.HandleFunc("POST /v1/zone", func(w http.ResponseWriter, r *http.Request) {
+		var rb adzerk.CreateZoneJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&rb); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		zoneIdCounter++
+		zone := &adzerk.Zone{Id: zoneIdCounter, Name: rb.Name, IsDeleted: rb.IsDeleted}
+		if rb.SiteId != nil {
+			zone.SiteId = *rb.SiteId
+		}
+		zones[zone.Id] = zone
+
+		testWriteJson(w, zone)
+	})
+
+	mux.HandleFunc("GET /v1/zone/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseInt(r.PathValue("id"), 10, 32)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		zone, found := zones[int32(id)]
+		if !found {
+			http.Error(w, "Not found", http.StatusNotFound)
+			return
+		}
+
+		testWriteJson(w, zone)
+	})
+
+	mux.HandleFunc("PUT /v1/zone/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseInt(r.PathValue("id"), 10, 32)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		var rb adzerk.UpdateZoneJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&rb); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		zone, found := zones[int32(id)]
+		if !found {
+			http.Error(w, "Not found", http.StatusNotFound)
+			return
+		}
+
+		zone.Name = rb.Name
+		zone.IsDeleted = rb.IsDeleted
+		if rb.SiteId != nil {
+			zone.SiteId = *rb.SiteId
+		}
+
+		testWriteJson(w, zone)
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func testWriteJson(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
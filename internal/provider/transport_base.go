@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// newBaseTransport returns the transport that connects to the Kevel API,
+// configured with the proxy, CA certificate and TLS verification settings
+// from the provider configuration.
+func newBaseTransport(proxyUrl types.String, caCertFile types.String, insecureSkipVerify types.Bool, diags *diag.Diagnostics) *http.Transport {
+	transport := &http.Transport{Proxy: http.ProxyFromEnvironment}
+	if defaultTransport, ok := http.DefaultTransport.(*http.Transport); ok {
+		transport = defaultTransport.Clone()
+	}
+
+	if !proxyUrl.IsNull() && !proxyUrl.IsUnknown() {
+		proxy, err := url.Parse(proxyUrl.ValueString())
+		if err != nil || proxy.Scheme == "" || proxy.Host == "" {
+			diags.AddAttributeError(path.Root("proxy_url"), "Invalid Proxy URL", "Could not parse "+proxyUrl.String()+" as an absolute URL")
+		} else {
+			transport.Proxy = http.ProxyURL(proxy)
+		}
+	}
+
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{}
+	}
+
+	if !caCertFile.IsNull() && !caCertFile.IsUnknown() {
+		pem, err := os.ReadFile(caCertFile.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("ca_cert_file"), "Invalid CA Certificate File", "Could not read "+caCertFile.String()+": "+err.Error())
+			return transport
+		}
+
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+
+		if !rootCAs.AppendCertsFromPEM(pem) {
+			diags.AddAttributeError(path.Root("ca_cert_file"), "Invalid CA Certificate File", "No PEM encoded certificates found in "+caCertFile.String())
+			return transport
+		}
+
+		transport.TLSClientConfig.RootCAs = rootCAs
+	}
+
+	transport.TLSClientConfig.InsecureSkipVerify = insecureSkipVerify.ValueBool()
+
+	return transport
+}
+
+// userAgent identifies the provider and the Terraform version driving it,
+// followed by any product tokens from the provider configuration.
+func userAgent(providerVersion string, terraformVersion string, extra string) string {
+	products := []string{"terraform-provider-kevel/" + providerVersion}
+
+	if terraformVersion != "" {
+		products = append(products, "Terraform/"+terraformVersion)
+	}
+
+	if extra = strings.TrimSpace(extra); extra != "" {
+		products = append(products, extra)
+	}
+
+	return strings.Join(products, " ")
+}
+
+func userAgentRequestEditor(userAgent string) func(ctx context.Context, req *http.Request) error {
+	return func(_ context.Context, req *http.Request) error {
+		req.Header.Set("User-Agent", userAgent)
+		return nil
+	}
+}
@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testWriteCertificateFile(t *testing.T, s *httptest.Server) string {
+	t.Helper()
+
+	certFile := filepath.Join(t.TempDir(), "ca.pem")
+	certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: s.Certificate().Raw})
+	if err := os.WriteFile(certFile, certPem, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return certFile
+}
+
+func TestBaseTransportTLS(t *testing.T) {
+	s := httptest.NewTLSServer(testOkHandler())
+	defer s.Close()
+
+	certFile := testWriteCertificateFile(t, s)
+
+	tests := map[string]struct {
+		caCertFile         types.String
+		insecureSkipVerify types.Bool
+		expectError        bool
+	}{
+		"default": {
+			caCertFile:         types.StringNull(),
+			insecureSkipVerify: types.BoolNull(),
+			expectError:        true,
+		},
+		"ca cert file": {
+			caCertFile:         types.StringValue(certFile),
+			insecureSkipVerify: types.BoolNull(),
+		},
+		"insecure skip verify": {
+			caCertFile:         types.StringNull(),
+			insecureSkipVerify: types.BoolValue(true),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			diags := diag.Diagnostics{}
+			transport := newBaseTransport(types.StringNull(), test.caCertFile, test.insecureSkipVerify, &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			response, err := (&http.Client{Transport: transport}).Get(s.URL)
+			if test.expectError {
+				if err == nil {
+					t.Errorf("expected certificate verification to fail")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			_ = response.Body.Close()
+		})
+	}
+}
+
+func TestBaseTransportInvalidConfiguration(t *testing.T) {
+	notPemFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(notPemFile, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		proxyUrl   types.String
+		caCertFile types.String
+	}{
+		"relative proxy url": {
+			proxyUrl:   types.StringValue("proxy.example.org"),
+			caCertFile: types.StringNull(),
+		},
+		"missing ca cert file": {
+			proxyUrl:   types.StringNull(),
+			caCertFile: types.StringValue(filepath.Join(t.TempDir(), "missing.pem")),
+		},
+		"ca cert file without certificates": {
+			proxyUrl:   types.StringNull(),
+			caCertFile: types.StringValue(notPemFile),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			diags := diag.Diagnostics{}
+			newBaseTransport(test.proxyUrl, test.caCertFile, types.BoolNull(), &diags)
+			if !diags.HasError() {
+				t.Errorf("expected an error diagnostic")
+			}
+		})
+	}
+}
+
+func TestBaseTransportProxy(t *testing.T) {
+	var proxiedUrl string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedUrl = r.URL.String()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+
+	diags := diag.Diagnostics{}
+	transport := newBaseTransport(types.StringValue(proxy.URL), types.StringNull(), types.BoolNull(), &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	response, err := (&http.Client{Transport: transport}).Get("http://api.kevel.example/v1/site")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = response.Body.Close()
+
+	if proxiedUrl != "http://api.kevel.example/v1/site" {
+		t.Errorf("expected request to be sent through proxy, got %q", proxiedUrl)
+	}
+}
+
+func TestUserAgent(t *testing.T) {
+	tests := map[string]struct {
+		terraformVersion string
+		extra            string
+		expected         string
+	}{
+		"provider only": {
+			expected: "terraform-provider-kevel/1.2.3",
+		},
+		"terraform": {
+			terraformVersion: "1.9.0",
+			expected:         "terraform-provider-kevel/1.2.3 Terraform/1.9.0",
+		},
+		"extra": {
+			terraformVersion: "1.9.0",
+			extra:            " my-pipeline/4 ",
+			expected:         "terraform-provider-kevel/1.2.3 Terraform/1.9.0 my-pipeline/4",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if actual := userAgent("1.2.3", test.terraformVersion, test.extra); actual != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, actual)
+			}
+		})
+	}
+}
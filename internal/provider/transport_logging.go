@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const redactedHeaderValue = "[REDACTED]"
+
+// redactedHeaders lists headers whose values are never logged, including the
+// API key header added by the security provider.
+var redactedHeaders = []string{
+	"X-Adzerk-ApiKey",
+	"Authorization",
+}
+
+// loggingTransport logs each request sent to the Kevel API. The method, URL,
+// status code and duration are logged at DEBUG, with headers and bodies
+// additionally logged at TRACE.
+type loggingTransport struct {
+	next http.RoundTripper
+}
+
+func newLoggingTransport(next http.RoundTripper) *loggingTransport {
+	return &loggingTransport{next: next}
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	fields := map[string]interface{}{
+		"http_method": req.Method,
+		"http_url":    req.URL.Redacted(),
+	}
+
+	tflog.Trace(ctx, "Sending Kevel API request", mergeFields(fields, map[string]interface{}{
+		"http_request_headers": redactHeaders(req.Header),
+		"http_request_body":    requestBodyString(req),
+	}))
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	duration := time.Since(start)
+
+	fields["http_duration_ms"] = duration.Milliseconds()
+
+	if err != nil {
+		tflog.Debug(ctx, "Kevel API request failed", mergeFields(fields, map[string]interface{}{
+			"error": err.Error(),
+		}))
+		return nil, err
+	}
+
+	fields["http_status_code"] = resp.StatusCode
+
+	tflog.Debug(ctx, "Kevel API request completed", fields)
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	tflog.Trace(ctx, "Received Kevel API response", mergeFields(fields, map[string]interface{}{
+		"http_response_headers": redactHeaders(resp.Header),
+		"http_response_body":    string(body),
+	}))
+
+	return resp, nil
+}
+
+func requestBodyString(req *http.Request) string {
+	if req.Body == nil || req.Body == http.NoBody || req.GetBody == nil {
+		return ""
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return ""
+	}
+	defer body.Close()
+
+	b, err := io.ReadAll(body)
+	if err != nil {
+		return ""
+	}
+
+	return string(b)
+}
+
+func redactHeaders(header http.Header) map[string]string {
+	redacted := make(map[string]string, len(header))
+	for name, values := range header {
+		redacted[name] = strings.Join(values, ", ")
+
+		for _, redactedName := range redactedHeaders {
+			if strings.EqualFold(name, redactedName) {
+				redacted[name] = redactedHeaderValue
+			}
+		}
+	}
+
+	return redacted
+}
+
+func mergeFields(fields map[string]interface{}, additional map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(fields)+len(additional))
+	for k, v := range fields {
+		merged[k] = v
+	}
+	for k, v := range additional {
+		merged[k] = v
+	}
+	return merged
+}
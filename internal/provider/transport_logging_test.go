@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/oapi-codegen/oapi-codegen/v2/pkg/securityprovider"
+
+	adzerk "github.com/cysp/adzerk-management-sdk-go"
+)
+
+func TestLoggingTransport(t *testing.T) {
+	const apiKey = "secret-api-key"
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Adzerk-ApiKey") != apiKey {
+			t.Errorf("expected API key header to reach the server")
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"Height":250,"Width":300}` {
+			t.Errorf("expected request body to reach the server, got %q", body)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"Id":1,"Width":300,"Height":250}`))
+	}))
+	defer s.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	apiKeySecurityProvider, err := securityprovider.NewSecurityProviderApiKey("header", "X-Adzerk-ApiKey", apiKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := adzerk.NewClientWithResponses(s.URL,
+		adzerk.WithHTTPClient(&http.Client{Transport: newLoggingTransport(http.DefaultTransport)}),
+		adzerk.WithRequestEditorFn(apiKeySecurityProvider.Intercept),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response, err := client.CreateAdTypeWithResponse(ctx, adzerk.CreateAdTypeJSONRequestBody{Width: 300, Height: 250})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if response.JSON200 == nil || response.JSON200.Id != 1 {
+		t.Fatalf("expected response body to be passed through, got %s", response.Body)
+	}
+
+	logs := output.String()
+	if logs == "" {
+		t.Fatalf("expected requests to be logged")
+	}
+	if strings.Contains(logs, apiKey) {
+		t.Errorf("expected API key to be redacted from logs")
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	messages := map[string]map[string]interface{}{}
+	for _, entry := range entries {
+		message, ok := entry["@message"].(string)
+		if !ok {
+			t.Fatalf("expected log entry to have a message: %v", entry)
+		}
+		messages[message] = entry
+	}
+
+	completed, found := messages["Kevel API request completed"]
+	if !found {
+		t.Fatalf("expected request completion to be logged, got %v", entries)
+	}
+	if completed["@level"] != "debug" || completed["http_method"] != "POST" || completed["http_status_code"] != float64(200) {
+		t.Errorf("unexpected request completion entry: %v", completed)
+	}
+	if _, found := completed["http_duration_ms"]; !found {
+		t.Errorf("expected request duration to be logged: %v", completed)
+	}
+
+	sent := messages["Sending Kevel API request"]
+	if sent["@level"] != "trace" || sent["http_request_body"] != `{"Height":250,"Width":300}` {
+		t.Errorf("unexpected request entry: %v", sent)
+	}
+	if headers, ok := sent["http_request_headers"].(map[string]interface{}); !ok || headers["X-Adzerk-Apikey"] != redactedHeaderValue {
+		t.Errorf("expected API key header to be redacted: %v", sent["http_request_headers"])
+	}
+
+	received := messages["Received Kevel API response"]
+	if received["@level"] != "trace" || received["http_response_body"] != `{"Id":1,"Width":300,"Height":250}` {
+		t.Errorf("unexpected response entry: %v", received)
+	}
+}
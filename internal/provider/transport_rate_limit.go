@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"io"
+	"math"
+	"net/http"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/time/rate"
+)
+
+// rateLimitTransport limits the rate and concurrency of requests sent by all
+// resources and data sources sharing a provider's client. A concurrency slot
+// is held until the response body is closed.
+type rateLimitTransport struct {
+	next    http.RoundTripper
+	limiter *rate.Limiter
+	slots   chan struct{}
+}
+
+// newRateLimitTransport returns a transport allowing requestsPerSecond
+// requests per second, or any number when zero, with at most
+// maxConcurrentRequests in flight, or any number when zero.
+func newRateLimitTransport(next http.RoundTripper, requestsPerSecond float64, maxConcurrentRequests int) *rateLimitTransport {
+	t := &rateLimitTransport{
+		next:    next,
+		limiter: rate.NewLimiter(rate.Inf, 0),
+	}
+
+	if requestsPerSecond > 0 {
+		t.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), int(math.Ceil(requestsPerSecond)))
+	}
+
+	if maxConcurrentRequests > 0 {
+		t.slots = make(chan struct{}, maxConcurrentRequests)
+	}
+
+	return t
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+		default:
+			tflog.Debug(ctx, "Throttling Kevel API request, waiting for a concurrent request to complete", map[string]interface{}{
+				"method":                  req.Method,
+				"url":                     req.URL.Redacted(),
+				"max_concurrent_requests": cap(t.slots),
+			})
+
+			select {
+			case t.slots <- struct{}{}:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+	}
+
+	reservation := t.limiter.Reserve()
+	if delay := reservation.Delay(); delay > 0 {
+		tflog.Debug(ctx, "Throttling Kevel API request, waiting for rate limit", map[string]interface{}{
+			"method":              req.Method,
+			"url":                 req.URL.Redacted(),
+			"requests_per_second": float64(t.limiter.Limit()),
+			"wait":                delay.String(),
+		})
+
+		if err := sleepContext(ctx, delay); err != nil {
+			reservation.Cancel()
+			t.release()
+			return nil, err
+		}
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		t.release()
+		return nil, err
+	}
+
+	resp.Body = &releasingReadCloser{ReadCloser: resp.Body, release: t.release}
+
+	return resp, nil
+}
+
+func (t *rateLimitTransport) release() {
+	if t.slots != nil {
+		<-t.slots
+	}
+}
+
+type releasingReadCloser struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (r *releasingReadCloser) Close() error {
+	err := r.ReadCloser.Close()
+	r.once.Do(r.release)
+	return err
+}
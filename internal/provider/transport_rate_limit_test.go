@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRateLimitTransportConcurrency(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+
+		for {
+			observed := maxInFlight.Load()
+			if current <= observed || maxInFlight.CompareAndSwap(observed, current) {
+				break
+			}
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}))
+	defer s.Close()
+
+	client := &http.Client{
+		Transport: newRateLimitTransport(http.DefaultTransport, 0, 2),
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			resp, err := client.Get(s.URL)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight.Load() > 2 {
+		t.Errorf("expected at most 2 concurrent requests, observed %d", maxInFlight.Load())
+	}
+}
+
+func TestRateLimitTransportRate(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer s.Close()
+
+	client := &http.Client{
+		Transport: newRateLimitTransport(http.DefaultTransport, 20, 0),
+	}
+
+	// The first 20 requests use the initial burst, the remaining 5 are
+	// spaced 50ms apart.
+	start := time.Now()
+	for i := 0; i < 25; i++ {
+		resp, err := client.Get(s.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Errorf("expected requests to be throttled, 25 requests took %s", elapsed)
+	}
+}
+
+func TestRateLimitTransportUnlimited(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer s.Close()
+
+	client := &http.Client{
+		Transport: newRateLimitTransport(http.DefaultTransport, 0, 0),
+	}
+
+	start := time.Now()
+	for i := 0; i < 100; i++ {
+		resp, err := client.Get(s.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected requests not to be throttled, 100 requests took %s", elapsed)
+	}
+}
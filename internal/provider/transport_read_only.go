@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// errReadOnly is returned for any request that could modify Kevel while the
+// provider is configured as read only.
+var errReadOnly = errors.New("the kevel provider is configured as read only")
+
+// readOnlyTransport refuses to send any request other than GET, HEAD and
+// OPTIONS, so that no resource can modify Kevel regardless of how it calls
+// the API. Kevel deletes ad types, channels and channel site maps with GET
+// requests to a path ending in /delete, so those are refused too.
+type readOnlyTransport struct {
+	next http.RoundTripper
+}
+
+func newReadOnlyTransport(next http.RoundTripper) *readOnlyTransport {
+	return &readOnlyTransport{
+		next: next,
+	}
+}
+
+func (t *readOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		if !strings.HasSuffix(req.URL.Path, "/delete") {
+			return t.next.RoundTrip(req)
+		}
+	}
+
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+
+	return nil, fmt.Errorf("%w, refusing to send %s %s", errReadOnly, req.Method, req.URL.Path)
+}
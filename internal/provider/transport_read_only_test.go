@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	adzerk "github.com/cysp/adzerk-management-sdk-go"
+	"github.com/cysp/adzerk-management-sdk-go/testserver"
+)
+
+func TestReadOnlyTransport(t *testing.T) {
+	var requests atomic.Int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer s.Close()
+
+	client := &http.Client{Transport: newReadOnlyTransport(http.DefaultTransport)}
+
+	for _, method := range []string{http.MethodGet, http.MethodHead} {
+		req, _ := http.NewRequest(method, s.URL, nil)
+		response, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", method, err)
+		}
+		_ = response.Body.Close()
+	}
+
+	for _, method := range []string{http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete} {
+		req, _ := http.NewRequest(method, s.URL, strings.NewReader(`{}`))
+		_, err := client.Do(req)
+		if !errors.Is(err, errReadOnly) {
+			t.Errorf("%s: expected read only error, got %v", method, err)
+		}
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, s.URL+"/v1/channel/1/delete", nil)
+	if _, err := client.Do(req); !errors.Is(err, errReadOnly) {
+		t.Errorf("GET /delete: expected read only error, got %v", err)
+	}
+
+	if requests.Load() != 2 {
+		t.Errorf("expected only 2 requests to reach the server, got %d", requests.Load())
+	}
+}
+
+func TestResourceDeleteReadOnly(t *testing.T) {
+	tests := map[string]struct {
+		resource fwresource.Resource
+		typeName string
+		state    string
+	}{
+		"ad type": {
+			resource: NewAdTypeResource(),
+			typeName: "kevel_ad_type",
+			state:    `{"id":1,"name":"one","width":300,"height":250,"timeouts":null}`,
+		},
+		"channel": {
+			resource: NewChannelResource(),
+			typeName: "kevel_channel",
+			state:    `{"id":1,"title":"one","ad_types":[1],"timeouts":null}`,
+		},
+		"channel site map": {
+			resource: NewChannelSiteMapResource(),
+			typeName: "kevel_channel_site_map",
+			state:    `{"id":"1:2","channel_id":1,"site_id":2,"priority":1,"timeouts":null}`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var requests atomic.Int32
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer s.Close()
+
+			client, err := adzerk.NewClientWithResponses(s.URL, adzerk.WithHTTPClient(&http.Client{Transport: newReadOnlyTransport(http.DefaultTransport)}))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r, ok := test.resource.(fwresource.ResourceWithConfigure)
+			if !ok {
+				t.Fatal("expected resource to implement ResourceWithConfigure")
+			}
+
+			configureResponse := fwresource.ConfigureResponse{}
+			r.Configure(ctx, fwresource.ConfigureRequest{ProviderData: newKevelProviderData(client)}, &configureResponse)
+			if configureResponse.Diagnostics.HasError() {
+				t.Fatalf("unexpected configure diagnostics: %v", configureResponse.Diagnostics)
+			}
+
+			state := testUpgradeResourceState(t, test.resource, test.typeName, 1, test.state)
+
+			deleteResponse := fwresource.DeleteResponse{State: state}
+			test.resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &deleteResponse)
+
+			if !deleteResponse.Diagnostics.HasError() {
+				t.Fatalf("expected delete to fail in read only mode")
+			}
+			if detail := deleteResponse.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, errReadOnly.Error()) {
+				t.Errorf("expected read only error, got %q", detail)
+			}
+
+			if requests.Load() != 0 {
+				t.Errorf("expected no requests to reach the server, got %d", requests.Load())
+			}
+		})
+	}
+}
+
+func TestSiteResourceReadOnly(t *testing.T) {
+	s := testserver.NewHttpTestServer()
+	defer s.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					`
+provider "kevel" {
+	alias = "read_only"
+	api_base_url = "`+s.URL+`"
+	api_key = "test"
+	read_only = true
+}
+
+data "kevel_sites" "test" {
+	provider = kevel.read_only
+}
+`,
+					testResourceConfig("site",
+						`provider = kevel.read_only`,
+						`title = "one"`,
+						`url = "https://example.org/one"`,
+					),
+				),
+				ExpectError: regexp.MustCompile(`configured\s+as\s+read\s+only,\s+refusing\s+to\s+send\s+POST`),
+			},
+		},
+	})
+}
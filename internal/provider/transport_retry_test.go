@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/cysp/adzerk-management-sdk-go/testserver"
+)
+
+// testFlakyServer responds to the first failures requests with the given
+// status code before passing requests through to handler.
+func testFlakyServer(handler http.Handler, failures int32, statusCode int, retryAfter string) (*httptest.Server, *atomic.Int32) {
+	var requests atomic.Int32
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) <= failures {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			http.Error(w, http.StatusText(statusCode), statusCode)
+			return
+		}
+
+		handler.ServeHTTP(w, r)
+	}))
+
+	return s, &requests
+}
+
+func testOkHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(body)
+	})
+}
+
+func TestRetryTransport(t *testing.T) {
+	testCases := map[string]struct {
+		method             string
+		failures           int32
+		statusCode         int
+		retryAfter         string
+		maxRetries         int
+		expectedStatusCode int
+		expectedRequests   int32
+	}{
+		"rate limited then succeeds": {
+			method:             http.MethodGet,
+			failures:           2,
+			statusCode:         http.StatusTooManyRequests,
+			retryAfter:         "0",
+			maxRetries:         3,
+			expectedStatusCode: http.StatusOK,
+			expectedRequests:   3,
+		},
+		"server error then succeeds": {
+			method:             http.MethodPut,
+			failures:           1,
+			statusCode:         http.StatusBadGateway,
+			maxRetries:         3,
+			expectedStatusCode: http.StatusOK,
+			expectedRequests:   2,
+		},
+		"retries exhausted": {
+			method:             http.MethodGet,
+			failures:           10,
+			statusCode:         http.StatusServiceUnavailable,
+			maxRetries:         2,
+			expectedStatusCode: http.StatusServiceUnavailable,
+			expectedRequests:   3,
+		},
+		"rate limited create is retried": {
+			method:             http.MethodPost,
+			failures:           1,
+			statusCode:         http.StatusTooManyRequests,
+			maxRetries:         3,
+			expectedStatusCode: http.StatusOK,
+			expectedRequests:   2,
+		},
+		"server error on create is not retried": {
+			method:             http.MethodPost,
+			failures:           1,
+			statusCode:         http.StatusInternalServerError,
+			maxRetries:         3,
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedRequests:   1,
+		},
+		"client error is not retried": {
+			method:             http.MethodGet,
+			failures:           1,
+			statusCode:         http.StatusBadRequest,
+			maxRetries:         3,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedRequests:   1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			s, requests := testFlakyServer(testOkHandler(), testCase.failures, testCase.statusCode, testCase.retryAfter)
+			defer s.Close()
+
+			client := &http.Client{
+				Transport: newRetryTransport(http.DefaultTransport, testCase.maxRetries, time.Millisecond, 10*time.Millisecond),
+			}
+
+			req, err := http.NewRequest(testCase.method, s.URL, strings.NewReader("body"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != testCase.expectedStatusCode {
+				t.Errorf("expected status code %d, got %d", testCase.expectedStatusCode, resp.StatusCode)
+			}
+
+			if requests.Load() != testCase.expectedRequests {
+				t.Errorf("expected %d requests, got %d", testCase.expectedRequests, requests.Load())
+			}
+
+			if resp.StatusCode == http.StatusOK {
+				body, _ := io.ReadAll(resp.Body)
+				if string(body) != "body" {
+					t.Errorf("expected request body to be resent, got %q", body)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryTransportContextCancelled(t *testing.T) {
+	s, _ := testFlakyServer(testOkHandler(), 10, http.StatusTooManyRequests, "60")
+	defer s.Close()
+
+	client := &http.Client{
+		Transport: newRetryTransport(http.DefaultTransport, 3, time.Millisecond, time.Minute),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	if err == nil {
+		resp.Body.Close()
+		t.Fatal("expected error")
+	}
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected cancellation to interrupt Retry-After wait, took %s", elapsed)
+	}
+}
+
+func TestRetryTransportBackoff(t *testing.T) {
+	transport := newRetryTransport(http.DefaultTransport, 5, 100*time.Millisecond, time.Second)
+
+	for attempt, expectedMax := range []time.Duration{100, 200, 400, 800, 1000, 1000} {
+		expectedMax *= time.Millisecond
+		wait := transport.backoff(attempt, &http.Response{Header: http.Header{}})
+		if wait < expectedMax/2 || wait > expectedMax {
+			t.Errorf("attempt %d: expected wait between %s and %s, got %s", attempt, expectedMax/2, expectedMax, wait)
+		}
+	}
+
+	wait := transport.backoff(0, &http.Response{Header: http.Header{"Retry-After": []string{"7"}}})
+	if wait != time.Second {
+		t.Errorf("expected Retry-After to be capped at maximum wait, got %s", wait)
+	}
+
+	retryAfterDate := time.Now().Add(500 * time.Millisecond).UTC().Format(http.TimeFormat)
+	wait = transport.backoff(0, &http.Response{Header: http.Header{"Retry-After": []string{retryAfterDate}}})
+	if wait > time.Second {
+		t.Errorf("expected Retry-After date to be honoured, got %s", wait)
+	}
+}
+
+func TestSiteResourceWithRetries(t *testing.T) {
+	ts := testserver.NewHttpTestServer()
+	defer ts.Close()
+
+	s, _ := testFlakyServer(ts.Config.Handler, 2, http.StatusTooManyRequests, "0")
+	defer s.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testCombinedConfig(
+					fmt.Sprintf(`
+provider "kevel" {
+	api_base_url = %[1]q
+	api_key = "test"
+	max_retries = 3
+	retry_min_wait = "1ms"
+	retry_max_wait = "10ms"
+}
+`, s.URL),
+					testSiteResourceConfig("one", "https://example.org/one"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("kevel_site.test", "id"),
+				),
+			},
+		},
+	})
+}
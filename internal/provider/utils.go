@@ -1,9 +1,15 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"net/http"
+	"regexp"
 	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -12,6 +18,27 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
+// defaultTimeout bounds each resource operation that has no duration
+// configured in its timeouts block.
+const defaultTimeout = 20 * time.Minute
+
+// nullTimeouts returns a null value for the timeouts block of a resource's
+// state, for upgrading state that was written before the block was added.
+func nullTimeouts(ctx context.Context, state tfsdk.State) (timeouts.Value, diag.Diagnostics) {
+	timeoutsType, diags := state.Schema.TypeAtPath(ctx, path.Root("timeouts"))
+	if diags.HasError() {
+		return timeouts.Value{}, diags
+	}
+
+	objectType, ok := timeoutsType.(attr.TypeWithAttributeTypes)
+	if !ok {
+		diags.AddError("Error Upgrading State", "Could not determine the attribute types of the timeouts block")
+		return timeouts.Value{}, diags
+	}
+
+	return timeouts.Value{Object: types.ObjectNull(objectType.AttributeTypes())}, diags
+}
+
 func NewInt64ValueFromInt32Pointer(value *int32) basetypes.Int64Value {
 	if value == nil {
 		return basetypes.NewInt64Null()
@@ -72,6 +99,17 @@ func ImportStatePassthroughInt64ID(ctx context.Context, attrPath path.Path, req
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, id)...)
 }
 
+// isNotFoundResponse reports whether a response indicates that the requested
+// object does not exist. Some Kevel endpoints answer with a null body rather
+// than a 404 status.
+func isNotFoundResponse(statusCode int, body []byte) bool {
+	if statusCode == http.StatusNotFound {
+		return true
+	}
+
+	return statusCode == http.StatusOK && bytes.Equal(bytes.TrimSpace(body), []byte("null"))
+}
+
 func Map[T, U any](ts []T, f func(T) U) []U {
 	us := make([]U, len(ts))
 	for i := range ts {
@@ -79,3 +117,36 @@ func Map[T, U any](ts []T, f func(T) U) []U {
 	}
 	return us
 }
+
+func compileRegexpAttribute(attributePath path.Path, value types.String, diags *diag.Diagnostics) *regexp.Regexp {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	re, err := regexp.Compile(value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(attributePath, "Invalid Regular Expression", "Could not compile "+value.String()+": "+err.Error())
+		return nil
+	}
+
+	return re
+}
+
+func parseDurationAttribute(attributePath path.Path, value types.String, defaultValue time.Duration, diags *diag.Diagnostics) time.Duration {
+	if value.IsNull() || value.IsUnknown() {
+		return defaultValue
+	}
+
+	duration, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(attributePath, "Invalid Duration", "Could not parse "+value.String()+": "+err.Error())
+		return defaultValue
+	}
+
+	if duration < 0 {
+		diags.AddAttributeError(attributePath, "Invalid Duration", "Duration "+value.String()+" must not be negative")
+		return defaultValue
+	}
+
+	return duration
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	adzerk "github.com/cysp/adzerk-management-sdk-go"
+)
+
+var zoneResponseFields = []responseField{
+	newResponseField("Name", path.Root("name")),
+	newResponseField("SiteId", path.Root("site_id")),
+}
+
+type zoneResourceModel struct {
+	Id       types.Int64    `tfsdk:"id"`
+	Name     types.String   `tfsdk:"name"`
+	SiteId   types.Int64    `tfsdk:"site_id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (m *zoneResourceModel) createRequestBody() adzerk.CreateZoneJSONRequestBody {
+	siteId := int32(m.SiteId.ValueInt64())
+	return adzerk.CreateZoneJSONRequestBody{
+		Name:   m.Name.ValueString(),
+		SiteId: &siteId,
+	}
+}
+
+func (m *zoneResourceModel) updateRequestBody() adzerk.UpdateZoneJSONRequestBody {
+	siteId := int32(m.SiteId.ValueInt64())
+	return adzerk.UpdateZoneJSONRequestBody{
+		Id:     int32(m.Id.ValueInt64()),
+		Name:   m.Name.ValueString(),
+		SiteId: &siteId,
+	}
+}
+
+func (m *zoneResourceModel) deleteRequestBody() adzerk.UpdateZoneJSONRequestBody {
+	isDeleted := true
+	body := m.updateRequestBody()
+	body.IsDeleted = &isDeleted
+	return body
+}
@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	adzerk "github.com/cysp/adzerk-management-sdk-go"
+)
+
+func setStateWithZone(s *tfsdk.State, ctx context.Context, zone *adzerk.Zone) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	if zone == nil {
+		diags.AddError("Error", "zone is nil")
+		return diags
+	}
+
+	SetInt64StateAttributeFromInt32(s, ctx, path.Root("id"), zone.Id, &diags)
+	SetStringStateAttribute(s, ctx, path.Root("name"), zone.Name, &diags)
+	SetInt64StateAttributeFromInt32(s, ctx, path.Root("site_id"), zone.SiteId, &diags)
+
+	return diags
+}
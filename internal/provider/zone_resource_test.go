@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	adzerk "github.com/cysp/adzerk-management-sdk-go"
+)
+
+func TestZoneResource(t *testing.T) {
+	s := newTestServerWithZones()
+	defer s.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testZoneResourceConfig("one", 1),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("kevel_zone.test", "id"),
+					resource.TestCheckResourceAttr("kevel_zone.test", "name", "one"),
+					resource.TestCheckResourceAttr("kevel_zone.test", "site_id", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "kevel_zone.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testZoneResourceConfig("two", 1),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("kevel_zone.test", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testZoneResourceConfig("two", 2),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("kevel_zone.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testZoneResourceConfig(name string, siteId int32) string {
+	nameField := fmt.Sprintf(`name = %q`, name)
+	siteIdField := fmt.Sprintf(`site_id = %d`, siteId)
+	return testResourceConfig("zone", nameField, siteIdField)
+}
+
+func TestZoneResourceDisappears(t *testing.T) {
+	s := newTestServerWithZones()
+	defer s.Close()
+
+	client := testClient(t, s.URL)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testCombinedConfig(
+					testProviderConfig(s.URL),
+					testZoneResourceConfig("one", 1),
+				),
+				Check: testCheckResourceAttrInt32Func("kevel_zone.test", "id", func(id int32) error {
+					isDeleted := true
+					_, err := client.UpdateZoneWithResponse(context.Background(), id, adzerk.UpdateZoneJSONRequestBody{Id: id, Name: "one", IsDeleted: &isDeleted})
+					return err
+				}),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("kevel_zone.test", plancheck.ResourceActionCreate),
+					},
+				},
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
@@ -3,7 +3,9 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"log"
+	"os"
 
 	"github.com/cysp/terraform-provider-kevel/internal/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -26,6 +28,13 @@ var (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "generate-imports" {
+		if err := generateImports(os.Args[2:]); err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
@@ -42,3 +51,43 @@ func main() {
 		log.Fatal(err.Error())
 	}
 }
+
+// generateImports writes import blocks and resource configuration for every
+// site, channel, ad type and channel site map in a network, reading
+// credentials from the environment or a credentials file like the provider.
+func generateImports(args []string) error {
+	var options provider.GenerateImportsOptions
+	var output string
+
+	flags := flag.NewFlagSet("generate-imports", flag.ExitOnError)
+	flags.StringVar(&options.ApiBaseUrl, "api-base-url", "", "Kevel API base URL, defaults to KEVEL_API_BASE_URL or the credentials profile")
+	flags.StringVar(&options.Profile, "profile", "", "credentials profile, defaults to KEVEL_PROFILE or \"default\"")
+	flags.StringVar(&options.CredentialsFile, "credentials-file", "", "credentials file, defaults to KEVEL_CREDENTIALS_FILE or ~/.kevel/credentials")
+	flags.StringVar(&output, "output", "", "file to write the configuration to instead of standard output")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s generate-imports [flags]\n\n", os.Args[0])
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	options.Version = version
+
+	if output == "" {
+		return provider.GenerateImports(context.Background(), os.Stdout, options)
+	}
+
+	f, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+
+	if err := provider.GenerateImports(context.Background(), f, options); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}